@@ -0,0 +1,118 @@
+package optional
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"errors"
+)
+
+const (
+	absentByte  byte = 0
+	presentByte byte = 1
+)
+
+var errInvalidBinaryData = errors.New("invalid binary data for Optional")
+
+// GobEncode implements the [gob.GobEncoder] interface.
+// An Optional is encoded as a presence byte, followed by the gob encoding of the value if present.
+func (o Optional[T]) GobEncode() ([]byte, error) {
+	if !o.present {
+		return []byte{absentByte}, nil
+	}
+
+	return appendGob([]byte{presentByte}, o.value)
+}
+
+// GobDecode implements the [gob.GobDecoder] interface.
+// It decodes data encoded by [Optional.GobEncode].
+func (o *Optional[T]) GobDecode(data []byte) error {
+	return o.decodeBinary(data, decodeGob[T])
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// An Optional is marshalled as a presence byte, followed by the binary representation of the value if present.
+// That is the result of the value's MarshalBinary method if it implements [encoding.BinaryMarshaler], or its gob encoding otherwise.
+func (o Optional[T]) MarshalBinary() ([]byte, error) {
+	if !o.present {
+		return []byte{absentByte}, nil
+	}
+
+	if marshaler, ok := any(o.value).(encoding.BinaryMarshaler); ok {
+		return appendBinary([]byte{presentByte}, marshaler)
+	}
+
+	if marshaler, ok := any(&o.value).(encoding.BinaryMarshaler); ok {
+		return appendBinary([]byte{presentByte}, marshaler)
+	}
+
+	return appendGob([]byte{presentByte}, o.value)
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// It unmarshals data marshalled by [Optional.MarshalBinary].
+func (o *Optional[T]) UnmarshalBinary(data []byte) error {
+	return o.decodeBinary(data, func(data []byte) (T, error) {
+		var value T
+		if unmarshaler, ok := any(&value).(encoding.BinaryUnmarshaler); ok {
+			err := unmarshaler.UnmarshalBinary(data)
+
+			return value, err
+		}
+
+		return decodeGob[T](data)
+	})
+}
+
+func (o *Optional[T]) decodeBinary(data []byte, decode func(data []byte) (T, error)) error {
+	if len(data) == 0 {
+		return errInvalidBinaryData
+	}
+
+	switch data[0] {
+	case absentByte:
+		if len(data) != 1 {
+			return errInvalidBinaryData
+		}
+
+		*o = Empty[T]()
+
+		return nil
+	case presentByte:
+		value, err := decode(data[1:])
+		if err != nil {
+			return err
+		}
+
+		*o = Of(value)
+
+		return nil
+	default:
+		return errInvalidBinaryData
+	}
+}
+
+func appendBinary(data []byte, marshaler encoding.BinaryMarshaler) ([]byte, error) {
+	binary, err := marshaler.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(data, binary...), nil
+}
+
+func appendGob[T any](data []byte, value T) ([]byte, error) {
+	buffer := bytes.NewBuffer(data)
+	if err := gob.NewEncoder(buffer).Encode(value); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func decodeGob[T any](data []byte) (T, error) {
+	var value T
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&value)
+
+	return value, err
+}
@@ -0,0 +1,221 @@
+package optional
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"testing"
+	"time"
+)
+
+type gobPerson struct {
+	Name    string
+	Age     Optional[int]
+	Email   Optional[string]
+	Address Optional[gobAddress]
+	Nested  Optional[Optional[string]]
+}
+
+type gobAddress struct {
+	City    string
+	Country Optional[string]
+}
+
+func TestGobEncodeWhenEmpty(t *testing.T) {
+	data, err := Empty[int]().GobEncode()
+	if err != nil {
+		t.Fatalf("optional.Empty().GobEncode should not return an error, was %v", err)
+	}
+
+	if !bytes.Equal(data, []byte{0}) {
+		t.Errorf("optional.Empty().GobEncode should return [0], was %v", data)
+	}
+}
+
+func TestGobEncodeWhenPresent(t *testing.T) {
+	data, err := Of(1).GobEncode()
+	if err != nil {
+		t.Fatalf("optional.Of(1).GobEncode should not return an error, was %v", err)
+	}
+
+	if len(data) < 2 || data[0] != 1 {
+		t.Errorf("optional.Of(1).GobEncode should return a presence byte 1 followed by the encoded value, was %v", data)
+	}
+}
+
+func TestGobRoundTrip(t *testing.T) {
+	parameters := []struct {
+		name   string
+		person gobPerson
+	}{
+		{"empty", gobPerson{Name: "John"}},
+		{"present", gobPerson{
+			Name:    "John",
+			Age:     Of(42),
+			Email:   Of(""),
+			Address: Of(gobAddress{City: "Amsterdam", Country: Of("NL")}),
+			Nested:  Of(Of("foo")),
+		}},
+		{"nested empty", gobPerson{Name: "John", Nested: Of(Empty[string]())}},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			var buffer bytes.Buffer
+
+			if err := gob.NewEncoder(&buffer).Encode(parameter.person); err != nil {
+				t.Fatalf("Encode should not return an error, was %v", err)
+			}
+
+			var result gobPerson
+			if err := gob.NewDecoder(&buffer).Decode(&result); err != nil {
+				t.Fatalf("Decode should not return an error, was %v", err)
+			}
+
+			if result != parameter.person {
+				t.Errorf("round trip should result in %v, was %v", parameter.person, result)
+			}
+		})
+	}
+}
+
+func TestGobDecodeWithInvalidData(t *testing.T) {
+	parameters := []struct {
+		name string
+		data []byte
+	}{
+		{"nil", nil},
+		{"empty", []byte{}},
+		{"trailing data after absent", []byte{0, 1}},
+		{"invalid presence byte", []byte{2}},
+		{"missing value", []byte{1}},
+		{"invalid value", []byte{1, 2, 3}},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			opt := Of(1)
+
+			if err := opt.GobDecode(parameter.data); err == nil {
+				t.Errorf("GobDecode(%v) should return an error", parameter.data)
+			}
+
+			if opt != Of(1) {
+				t.Errorf("GobDecode with invalid data should not modify the Optional, was %v", opt)
+			}
+		})
+	}
+}
+
+func TestMarshalBinaryWhenEmpty(t *testing.T) {
+	data, err := Empty[time.Time]().MarshalBinary()
+	if err != nil {
+		t.Fatalf("optional.Empty().MarshalBinary should not return an error, was %v", err)
+	}
+
+	if !bytes.Equal(data, []byte{0}) {
+		t.Errorf("optional.Empty().MarshalBinary should return [0], was %v", data)
+	}
+}
+
+func TestMarshalBinaryWithBinaryMarshaler(t *testing.T) {
+	now := time.Now()
+
+	expected, err := now.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary should not return an error, was %v", err)
+	}
+
+	data, err := Of(now).MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary should not return an error, was %v", err)
+	}
+
+	if !bytes.Equal(data, append([]byte{1}, expected...)) {
+		t.Errorf("MarshalBinary should return a presence byte 1 followed by %v, was %v", expected, data)
+	}
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	now := time.Now()
+
+	t.Run("empty", func(t *testing.T) {
+		testBinaryRoundTrip(t, Empty[int]())
+	})
+	t.Run("int", func(t *testing.T) {
+		testBinaryRoundTrip(t, Of(1))
+	})
+	t.Run("zero int", func(t *testing.T) {
+		testBinaryRoundTrip(t, Of(0))
+	})
+	t.Run("struct", func(t *testing.T) {
+		testBinaryRoundTrip(t, Of(gobAddress{City: "Amsterdam", Country: Of("NL")}))
+	})
+	t.Run("nested", func(t *testing.T) {
+		testBinaryRoundTrip(t, Of(Of("foo")))
+	})
+	t.Run("nested empty", func(t *testing.T) {
+		testBinaryRoundTrip(t, Of(Empty[string]()))
+	})
+	t.Run("binary marshaler", func(t *testing.T) {
+		var result Optional[time.Time]
+
+		data, err := Of(now).MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary should not return an error, was %v", err)
+		}
+
+		if err := result.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary should not return an error, was %v", err)
+		}
+
+		if value, ok := result.Get(); !ok || !value.Equal(now) {
+			t.Errorf("round trip should result in %v, was %v", now, result)
+		}
+	})
+}
+
+func testBinaryRoundTrip[T comparable](t *testing.T, opt Optional[T]) {
+	t.Helper()
+
+	data, err := opt.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary should not return an error, was %v", err)
+	}
+
+	var result Optional[T]
+	if err := result.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary should not return an error, was %v", err)
+	}
+
+	if result != opt {
+		t.Errorf("round trip should result in %v, was %v", opt, result)
+	}
+}
+
+func TestUnmarshalBinaryWithInvalidData(t *testing.T) {
+	parameters := []struct {
+		name        string
+		unmarshaler encoding.BinaryUnmarshaler
+		data        []byte
+	}{
+		{"empty", &Optional[int]{}, []byte{}},
+		{"invalid presence byte", &Optional[int]{}, []byte{2}},
+		{"invalid gob value", &Optional[int]{}, []byte{1, 2, 3}},
+		{"invalid binary value", &Optional[time.Time]{}, []byte{1, 2, 3}},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			if err := parameter.unmarshaler.UnmarshalBinary(parameter.data); err == nil {
+				t.Errorf("UnmarshalBinary(%v) should return an error", parameter.data)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package optional
+
+// Cloner is implemented by types that can create deep copies of their values.
+// Types that contain slices, maps or pointers can implement it to prevent Optionals from sharing these with their callers.
+// It can be implemented on either the type itself or a pointer to the type.
+type Cloner[T any] interface {
+	// Clone returns a deep copy of the value.
+	Clone() T
+}
+
+// OfClone returns a non-empty Optional describing a copy of the target of the given pointer if it's not nil,
+// or an empty Optional otherwise.
+//
+// Like [OfNillable], the target is always copied. If T implements [Cloner], that copy is a deep copy created using its Clone method.
+func OfClone[T any](value *T) Optional[T] {
+	if value == nil {
+		return Empty[T]()
+	}
+
+	return Of(cloneValue(*value))
+}
+
+// Clone returns an Optional with a copy of the value if present, or the Optional itself otherwise.
+// If T implements [Cloner], the copy is a deep copy created using its Clone method; otherwise it's a shallow copy.
+func (o Optional[T]) Clone() Optional[T] {
+	if !o.present {
+		return o
+	}
+
+	return Of(cloneValue(o.value))
+}
+
+// Ptr returns a pointer to a copy of the value if present, or nil otherwise.
+// If T implements [Cloner], the copy is a deep copy created using its Clone method; otherwise it's a shallow copy.
+// Changes to the pointer's target are not reflected in the Optional.
+func (o Optional[T]) Ptr() *T {
+	if !o.present {
+		return nil
+	}
+
+	value := cloneValue(o.value)
+
+	return &value
+}
+
+func cloneValue[T any](value T) T {
+	if cloner, ok := any(value).(Cloner[T]); ok {
+		return cloner.Clone()
+	}
+
+	if cloner, ok := any(&value).(Cloner[T]); ok {
+		return cloner.Clone()
+	}
+
+	return value
+}
@@ -0,0 +1,152 @@
+package optional
+
+import (
+	"maps"
+	"slices"
+	"sync"
+	"testing"
+)
+
+type cloneTags struct {
+	values []string
+}
+
+func (t cloneTags) Clone() cloneTags {
+	return cloneTags{values: slices.Clone(t.values)}
+}
+
+type clonePointerTags struct {
+	values map[string]int
+}
+
+func (t *clonePointerTags) Clone() clonePointerTags {
+	return clonePointerTags{values: maps.Clone(t.values)}
+}
+
+type cloneShallow struct {
+	values []string
+}
+
+func TestOfCloneWithNil(t *testing.T) {
+	if opt := OfClone[cloneTags](nil); !opt.IsEmpty() {
+		t.Errorf("optional.OfClone(nil) should return an empty Optional, was %v", opt)
+	}
+}
+
+func TestOfCloneWithCloner(t *testing.T) {
+	tags := cloneTags{values: []string{"a"}}
+
+	opt := OfClone(&tags)
+
+	tags.values[0] = "b"
+
+	if value, _ := opt.Get(); value.values[0] != "a" {
+		t.Errorf("optional.OfClone should not be affected by changes to the original value, was %v", value)
+	}
+}
+
+func TestOfCloneWithPointerCloner(t *testing.T) {
+	tags := clonePointerTags{values: map[string]int{"a": 1}}
+
+	opt := OfClone(&tags)
+
+	tags.values["a"] = 2
+
+	if value, _ := opt.Get(); value.values["a"] != 1 {
+		t.Errorf("optional.OfClone should not be affected by changes to the original value, was %v", value)
+	}
+}
+
+func TestOfCloneWithoutCloner(t *testing.T) {
+	shallow := cloneShallow{values: []string{"a"}}
+
+	opt := OfClone(&shallow)
+
+	shallow.values = []string{"b"}
+
+	if value, _ := opt.Get(); value.values[0] != "a" {
+		t.Errorf("optional.OfClone should not be affected by changes to the original value, was %v", value)
+	}
+}
+
+func TestClone(t *testing.T) {
+	opt := Of(cloneTags{values: []string{"a"}})
+
+	clone := opt.Clone()
+
+	value, _ := opt.Get()
+	value.values[0] = "b"
+
+	if cloneValue, _ := clone.Get(); cloneValue.values[0] != "a" {
+		t.Errorf("Clone should not be affected by changes to the original value, was %v", cloneValue)
+	}
+}
+
+func TestCloneWhenEmpty(t *testing.T) {
+	opt := Empty[cloneTags]()
+
+	if clone := opt.Clone(); clone.IsPresent() {
+		t.Errorf("Clone of an empty Optional should return the Optional itself, was %v", clone)
+	}
+}
+
+func TestPtrWhenEmpty(t *testing.T) {
+	if p := Empty[int]().Ptr(); p != nil {
+		t.Errorf("optional.Empty().Ptr should return nil, was %v", *p)
+	}
+}
+
+func TestPtrWhenPresent(t *testing.T) {
+	opt := Of(1)
+
+	p := opt.Ptr()
+	if p == nil || *p != 1 {
+		t.Fatalf("optional.Of(1).Ptr should return a pointer to 1, was %v", p)
+	}
+
+	*p = 2
+
+	if value := opt.OrElse(0); value != 1 {
+		t.Errorf("optional.Of(1) should not be affected by changes to the pointer returned by Ptr, was %v", value)
+	}
+
+	if p2 := opt.Ptr(); p2 == p {
+		t.Error("Ptr should return a new pointer for each call")
+	}
+}
+
+func TestPtrWithCloner(t *testing.T) {
+	opt := Of(cloneTags{values: []string{"a"}})
+
+	p := opt.Ptr()
+	p.values[0] = "b"
+
+	if value, _ := opt.Get(); value.values[0] != "a" {
+		t.Errorf("Optional should not be affected by changes to the pointer returned by Ptr, was %v", value)
+	}
+}
+
+func TestPtrConcurrentModification(t *testing.T) {
+	opt := Of(cloneTags{values: []string{"a", "b", "c"}})
+
+	var wg sync.WaitGroup
+
+	for range 4 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			p := opt.Ptr()
+			for i := range p.values {
+				p.values[i] = "x"
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if value, _ := opt.Get(); !slices.Equal(value.values, []string{"a", "b", "c"}) {
+		t.Errorf("Optional should not be affected by concurrent changes to the pointers returned by Ptr, was %v", value)
+	}
+}
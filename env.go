@@ -0,0 +1,120 @@
+package optional
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+var errInvalidLoadEnvArgument = errors.New("cfg must be a non-nil pointer to a struct")
+
+// LookupEnv returns a non-empty Optional containing the value of the environment variable with the given key if it's set,
+// or an empty Optional otherwise.
+// An environment variable that is set to an empty value results in a non-empty Optional containing an empty string.
+func LookupEnv(key string) Optional[string] {
+	return OfOk(os.LookupEnv(key))
+}
+
+// LookupEnvFunc returns a non-empty Optional containing the value of the environment variable with the given key, parsed using the given function,
+// if it's set, or an empty Optional otherwise.
+// If the parse function returns an error, LookupEnvFunc returns an empty Optional and that error.
+func LookupEnvFunc[T any](key string, parse func(s string) (T, error)) (Optional[T], error) {
+	return TryMap(LookupEnv(key), parse)
+}
+
+// LookupEnvText is like [LookupEnvFunc], but the value is parsed the same way as non-empty text is unmarshalled by [Optional.UnmarshalText].
+func LookupEnvText[T any](key string) (Optional[T], error) {
+	return LookupEnvFunc(key, func(s string) (T, error) {
+		return unmarshalText[T]([]byte(s))
+	})
+}
+
+// LookupEnvBool is like [LookupEnvFunc], but the value is parsed using [strconv.ParseBool].
+func LookupEnvBool(key string) (Optional[bool], error) {
+	return LookupEnvFunc(key, strconv.ParseBool)
+}
+
+// LookupEnvInt is like [LookupEnvFunc], but the value is parsed using [strconv.Atoi].
+func LookupEnvInt(key string) (Optional[int], error) {
+	return LookupEnvFunc(key, strconv.Atoi)
+}
+
+// LookupEnvDuration is like [LookupEnvFunc], but the value is parsed using [time.ParseDuration].
+func LookupEnvDuration(key string) (Optional[time.Duration], error) {
+	return LookupEnvFunc(key, time.ParseDuration)
+}
+
+type textSetter interface {
+	setText(text string) error
+}
+
+func (o *Optional[T]) setText(text string) error {
+	value, err := unmarshalText[T]([]byte(text))
+	if err != nil {
+		return err
+	}
+
+	*o = Of(value)
+
+	return nil
+}
+
+// LoadEnv sets Optional fields of the struct that cfg points to from environment variables.
+// The name of the environment variable for a field is taken from its env tag:
+//
+//	type Config struct {
+//		Port    optional.Optional[int]           `env:"PORT"`
+//		Timeout optional.Optional[time.Duration] `env:"TIMEOUT"`
+//	}
+//
+// The value of an environment variable is parsed the same way as non-empty text is unmarshalled by [Optional.UnmarshalText].
+// If an environment variable is not set, its field keeps its current value; for newly created values that means it will remain empty.
+//
+// Struct fields without env tag are loaded recursively. Any other fields without env tag are ignored.
+// An error is returned if a field with an env tag is not an Optional, or if the value of an environment variable cannot be parsed.
+// In that case, fields for other environment variables are still set.
+func LoadEnv(cfg any) error {
+	value := reflect.ValueOf(cfg)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return errInvalidLoadEnvArgument
+	}
+
+	return loadEnv(value.Elem())
+}
+
+func loadEnv(value reflect.Value) error {
+	var errs []error
+
+	valueType := value.Type()
+	for i := range valueType.NumField() {
+		field := valueType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		fieldValue := value.Field(i)
+		setter, isOptional := fieldValue.Addr().Interface().(textSetter)
+
+		key, hasTag := field.Tag.Lookup("env")
+
+		switch {
+		case hasTag && !isOptional:
+			errs = append(errs, fmt.Errorf("field %s with env tag is not an Optional", field.Name))
+		case hasTag:
+			if text, ok := os.LookupEnv(key); ok {
+				if err := setter.setText(text); err != nil {
+					errs = append(errs, fmt.Errorf("cannot set field %s from environment variable %s: %w", field.Name, key, err))
+				}
+			}
+		case !isOptional && fieldValue.Kind() == reflect.Struct:
+			if err := loadEnv(fieldValue); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
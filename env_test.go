@@ -0,0 +1,200 @@
+package optional
+
+import (
+	"errors"
+	"net/netip"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type envConfig struct {
+	Name     Optional[string]        `env:"OPTIONAL_TEST_NAME"`
+	Port     Optional[int]           `env:"OPTIONAL_TEST_PORT"`
+	Timeout  Optional[time.Duration] `env:"OPTIONAL_TEST_TIMEOUT"`
+	Addr     Optional[netip.Addr]    `env:"OPTIONAL_TEST_ADDR"`
+	Debug    Optional[bool]          `env:"OPTIONAL_TEST_DEBUG"`
+	Database envDatabaseConfig
+	Other    string
+	hidden   Optional[string] `env:"OPTIONAL_TEST_HIDDEN"`
+}
+
+type envDatabaseConfig struct {
+	URL Optional[string] `env:"OPTIONAL_TEST_DATABASE_URL"`
+}
+
+func TestLookupEnv(t *testing.T) {
+	t.Setenv("OPTIONAL_TEST_SET", "foo")
+	t.Setenv("OPTIONAL_TEST_EMPTY", "")
+
+	if opt := LookupEnv("OPTIONAL_TEST_SET"); opt != Of("foo") {
+		t.Errorf("LookupEnv should return optional.Of('foo'), was %v", opt)
+	}
+
+	if opt := LookupEnv("OPTIONAL_TEST_EMPTY"); opt != Of("") {
+		t.Errorf("LookupEnv should return optional.Of(''), was %v", opt)
+	}
+
+	if opt := LookupEnv("OPTIONAL_TEST_NOT_SET"); opt != Empty[string]() {
+		t.Errorf("LookupEnv should return optional.Empty(), was %v", opt)
+	}
+}
+
+func TestLookupEnvFunc(t *testing.T) {
+	t.Setenv("OPTIONAL_TEST_VALID", "1")
+	t.Setenv("OPTIONAL_TEST_INVALID", "foo")
+
+	if opt, err := LookupEnvFunc("OPTIONAL_TEST_VALID", strconv.Atoi); err != nil || opt != Of(1) {
+		t.Errorf("LookupEnvFunc should return (optional.Of(1), nil), was (%v, %v)", opt, err)
+	}
+
+	if opt, err := LookupEnvFunc("OPTIONAL_TEST_NOT_SET", strconv.Atoi); err != nil || opt != Empty[int]() {
+		t.Errorf("LookupEnvFunc should return (optional.Empty(), nil), was (%v, %v)", opt, err)
+	}
+
+	if opt, err := LookupEnvFunc("OPTIONAL_TEST_INVALID", strconv.Atoi); err == nil || !opt.IsEmpty() {
+		t.Errorf("LookupEnvFunc should return an empty Optional and an error, was (%v, %v)", opt, err)
+	}
+}
+
+func TestLookupEnvTyped(t *testing.T) {
+	t.Setenv("OPTIONAL_TEST_BOOL", "true")
+	t.Setenv("OPTIONAL_TEST_INT", "0")
+	t.Setenv("OPTIONAL_TEST_DURATION", "1m")
+	t.Setenv("OPTIONAL_TEST_TEXT", "::1")
+	t.Setenv("OPTIONAL_TEST_INVALID", "foo")
+
+	if opt, err := LookupEnvBool("OPTIONAL_TEST_BOOL"); err != nil || opt != Of(true) {
+		t.Errorf("LookupEnvBool should return (optional.Of(true), nil), was (%v, %v)", opt, err)
+	}
+
+	if opt, err := LookupEnvInt("OPTIONAL_TEST_INT"); err != nil || opt != Of(0) {
+		t.Errorf("LookupEnvInt should return (optional.Of(0), nil), was (%v, %v)", opt, err)
+	}
+
+	if opt, err := LookupEnvDuration("OPTIONAL_TEST_DURATION"); err != nil || opt != Of(time.Minute) {
+		t.Errorf("LookupEnvDuration should return (optional.Of(1m), nil), was (%v, %v)", opt, err)
+	}
+
+	if opt, err := LookupEnvText[netip.Addr]("OPTIONAL_TEST_TEXT"); err != nil || opt != Of(netip.IPv6Loopback()) {
+		t.Errorf("LookupEnvText should return (optional.Of(::1), nil), was (%v, %v)", opt, err)
+	}
+
+	if _, err := LookupEnvBool("OPTIONAL_TEST_INVALID"); err == nil {
+		t.Error("LookupEnvBool with an invalid value should return an error")
+	}
+
+	if _, err := LookupEnvInt("OPTIONAL_TEST_INVALID"); err == nil {
+		t.Error("LookupEnvInt with an invalid value should return an error")
+	}
+
+	if _, err := LookupEnvDuration("OPTIONAL_TEST_INVALID"); err == nil {
+		t.Error("LookupEnvDuration with an invalid value should return an error")
+	}
+
+	if _, err := LookupEnvText[netip.Addr]("OPTIONAL_TEST_INVALID"); err == nil {
+		t.Error("LookupEnvText with an invalid value should return an error")
+	}
+}
+
+func TestLoadEnv(t *testing.T) {
+	t.Setenv("OPTIONAL_TEST_NAME", "")
+	t.Setenv("OPTIONAL_TEST_PORT", "8080")
+	t.Setenv("OPTIONAL_TEST_ADDR", "127.0.0.1")
+	t.Setenv("OPTIONAL_TEST_DATABASE_URL", "postgres://localhost")
+	t.Setenv("OPTIONAL_TEST_HIDDEN", "foo")
+
+	cfg := envConfig{
+		Debug: Of(true),
+	}
+
+	if err := LoadEnv(&cfg); err != nil {
+		t.Fatalf("LoadEnv should not return an error, was %v", err)
+	}
+
+	if cfg.Name != Of("") {
+		t.Errorf("name should be optional.Of(''), was %v", cfg.Name)
+	}
+
+	if cfg.Port != Of(8080) {
+		t.Errorf("port should be optional.Of(8080), was %v", cfg.Port)
+	}
+
+	if cfg.Timeout != Empty[time.Duration]() {
+		t.Errorf("timeout should be empty, was %v", cfg.Timeout)
+	}
+
+	if cfg.Addr != Of(netip.MustParseAddr("127.0.0.1")) {
+		t.Errorf("addr should be optional.Of(127.0.0.1), was %v", cfg.Addr)
+	}
+
+	if cfg.Debug != Of(true) {
+		t.Errorf("debug should be unchanged, was %v", cfg.Debug)
+	}
+
+	if cfg.Database.URL != Of("postgres://localhost") {
+		t.Errorf("database.url should be optional.Of('postgres://localhost'), was %v", cfg.Database.URL)
+	}
+
+	if cfg.hidden != Empty[string]() {
+		t.Errorf("hidden should be empty, was %v", cfg.hidden)
+	}
+}
+
+func TestLoadEnvWithInvalidValues(t *testing.T) {
+	t.Setenv("OPTIONAL_TEST_NAME", "John")
+	t.Setenv("OPTIONAL_TEST_PORT", "foo")
+	t.Setenv("OPTIONAL_TEST_TIMEOUT", "foo")
+
+	var cfg envConfig
+
+	err := LoadEnv(&cfg)
+	if err == nil {
+		t.Fatal("LoadEnv should return an error")
+	}
+
+	var numErr *strconv.NumError
+	if !errors.As(err, &numErr) {
+		t.Errorf("LoadEnv should return an error containing a *strconv.NumError, was %v", err)
+	}
+
+	if cfg.Name != Of("John") {
+		t.Errorf("name should be optional.Of('John'), was %v", cfg.Name)
+	}
+
+	if !cfg.Port.IsEmpty() || !cfg.Timeout.IsEmpty() {
+		t.Errorf("port and timeout should be empty, was %v and %v", cfg.Port, cfg.Timeout)
+	}
+}
+
+func TestLoadEnvWithNonOptionalField(t *testing.T) {
+	cfg := struct {
+		Name string `env:"OPTIONAL_TEST_NAME"`
+	}{}
+
+	if err := LoadEnv(&cfg); err == nil {
+		t.Error("LoadEnv with an env tag on a non-Optional field should return an error")
+	}
+}
+
+func TestLoadEnvWithInvalidArgument(t *testing.T) {
+	parameters := []struct {
+		name string
+		cfg  any
+	}{
+		{"nil", nil},
+		{"non-pointer", envConfig{}},
+		{"nil pointer", (*envConfig)(nil)},
+		{"pointer to non-struct", new(int)},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			if err := LoadEnv(parameter.cfg); err == nil {
+				t.Error("LoadEnv should return an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package optional
+
+import (
+	"errors"
+	"reflect"
+)
+
+// ErrNoValuePresent indicates that the value of an empty Optional was requested.
+//
+// Errors returned by [Optional.OrElseError] and [Optional.Because], and values that [Optional.OrElsePanic] panics with, match this error using [errors.Is].
+var ErrNoValuePresent = errors.New("no value present")
+
+// NoValueError is the error returned by [Optional.OrElseError] and [Optional.Because], and the value that [Optional.OrElsePanic] panics with,
+// if the Optional is empty. It is also the error of Results created by [Err] with a nil error.
+// It wraps [ErrNoValuePresent], as well as its cause if there is one.
+type NoValueError struct {
+	// Type is the name of the Optional's generic type.
+	Type string
+	// Cause is the reason given to [Optional.Because], or nil if no reason was given.
+	Cause error
+}
+
+func newNoValueError[T any](cause error) *NoValueError {
+	return &NoValueError{
+		Type:  reflect.TypeFor[T]().String(),
+		Cause: cause,
+	}
+}
+
+// Error implements the error interface.
+func (e *NoValueError) Error() string {
+	if e.Cause == nil {
+		return ErrNoValuePresent.Error()
+	}
+
+	return ErrNoValuePresent.Error() + ": " + e.Cause.Error()
+}
+
+// Unwrap returns [ErrNoValuePresent], and the cause if it's not nil.
+func (e *NoValueError) Unwrap() []error {
+	if e.Cause == nil {
+		return []error{ErrNoValuePresent}
+	}
+
+	return []error{ErrNoValuePresent, e.Cause}
+}
@@ -0,0 +1,84 @@
+package optional
+
+import (
+	"errors"
+	"io"
+	"testing"
+)
+
+type errorsTestStruct struct{}
+
+func TestNoValueErrorType(t *testing.T) {
+	parameters := []struct {
+		err      *NoValueError
+		expected string
+	}{
+		{newNoValueError[int](nil), "int"},
+		{newNoValueError[*string](nil), "*string"},
+		{newNoValueError[[]byte](nil), "[]uint8"},
+		{newNoValueError[error](nil), "error"},
+		{newNoValueError[errorsTestStruct](nil), "optional.errorsTestStruct"},
+		{newNoValueError[Optional[int]](nil), "optional.Optional[int]"},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.expected, func(t *testing.T) {
+			if parameter.err.Type != parameter.expected {
+				t.Errorf("type should be '%s', was '%s'", parameter.expected, parameter.err.Type)
+			}
+		})
+	}
+}
+
+func TestNoValueErrorIs(t *testing.T) {
+	var err error = newNoValueError[int](nil)
+
+	if !errors.Is(err, ErrNoValuePresent) {
+		t.Errorf("%v should match ErrNoValuePresent", err)
+	}
+
+	if errors.Is(err, io.EOF) {
+		t.Errorf("%v should not match io.EOF", err)
+	}
+}
+
+func TestNoValueErrorAs(t *testing.T) {
+	_, err := Empty[int]().OrElseError()
+
+	var noValueError *NoValueError
+	if !errors.As(err, &noValueError) {
+		t.Fatalf("%v should be a *NoValueError", err)
+	}
+
+	if noValueError.Type != "int" {
+		t.Errorf("type should be 'int', was '%s'", noValueError.Type)
+	}
+}
+
+func TestNoValueErrorMessage(t *testing.T) {
+	err := newNoValueError[int](nil)
+
+	expected := "no value present"
+	if err.Error() != expected {
+		t.Errorf("message should be '%s', was '%s'", expected, err.Error())
+	}
+}
+
+func TestNoValueErrorWithCause(t *testing.T) {
+	var err error = newNoValueError[int](io.EOF)
+
+	expected := "no value present: EOF"
+	if err.Error() != expected {
+		t.Errorf("message should be '%s', was '%s'", expected, err.Error())
+	}
+
+	if !errors.Is(err, ErrNoValuePresent) {
+		t.Errorf("%v should match ErrNoValuePresent", err)
+	}
+
+	if !errors.Is(err, io.EOF) {
+		t.Errorf("%v should match io.EOF", err)
+	}
+}
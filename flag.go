@@ -0,0 +1,129 @@
+package optional
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// flagValue is a flag.Value that sets an Optional only if the flag is present on the command line.
+type flagValue[T any] struct {
+	optional *Optional[T]
+	parse    func(s string) (T, error)
+}
+
+func (f *flagValue[T]) String() string {
+	if f == nil || f.optional == nil || !f.optional.present {
+		return ""
+	}
+
+	return fmt.Sprint(f.optional.value)
+}
+
+func (f *flagValue[T]) Set(s string) error {
+	value, err := f.parse(s)
+	if err != nil {
+		return err
+	}
+
+	*f.optional = Of(value)
+
+	return nil
+}
+
+func (f *flagValue[T]) Get() any {
+	return *f.optional
+}
+
+type boolFlagValue struct {
+	flagValue[bool]
+}
+
+func (f *boolFlagValue) IsBoolFlag() bool {
+	return true
+}
+
+// Var defines a flag with the given name and usage string that sets the Optional that p points to, using the given function to parse its value.
+// If the flag is not present on the command line, the Optional keeps its current value; for newly created values that means it will remain empty.
+// This makes it possible to distinguish between flags that are not present on the command line, and flags that are explicitly set to the zero value.
+func Var[T any](fs *flag.FlagSet, p *Optional[T], name string, usage string, parse func(s string) (T, error)) {
+	fs.Var(&flagValue[T]{optional: p, parse: parse}, name, usage)
+}
+
+// BoolVar defines a bool flag with the given name and usage string that sets the Optional that p points to.
+// Like [flag.FlagSet.BoolVar], the flag can be present on the command line without a value, which sets it to true.
+// See [Var] for more information.
+func BoolVar(fs *flag.FlagSet, p *Optional[bool], name string, usage string) {
+	fs.Var(&boolFlagValue{flagValue[bool]{optional: p, parse: strconv.ParseBool}}, name, usage)
+}
+
+// IntVar defines an int flag with the given name and usage string that sets the Optional that p points to.
+// See [Var] for more information.
+func IntVar(fs *flag.FlagSet, p *Optional[int], name string, usage string) {
+	Var(fs, p, name, usage, func(s string) (int, error) {
+		value, err := strconv.ParseInt(s, 0, strconv.IntSize)
+
+		return int(value), err
+	})
+}
+
+// Int64Var defines an int64 flag with the given name and usage string that sets the Optional that p points to.
+// See [Var] for more information.
+func Int64Var(fs *flag.FlagSet, p *Optional[int64], name string, usage string) {
+	Var(fs, p, name, usage, func(s string) (int64, error) {
+		return strconv.ParseInt(s, 0, 64)
+	})
+}
+
+// UintVar defines a uint flag with the given name and usage string that sets the Optional that p points to.
+// See [Var] for more information.
+func UintVar(fs *flag.FlagSet, p *Optional[uint], name string, usage string) {
+	Var(fs, p, name, usage, func(s string) (uint, error) {
+		value, err := strconv.ParseUint(s, 0, strconv.IntSize)
+
+		return uint(value), err
+	})
+}
+
+// Uint64Var defines a uint64 flag with the given name and usage string that sets the Optional that p points to.
+// See [Var] for more information.
+func Uint64Var(fs *flag.FlagSet, p *Optional[uint64], name string, usage string) {
+	Var(fs, p, name, usage, func(s string) (uint64, error) {
+		return strconv.ParseUint(s, 0, 64)
+	})
+}
+
+// Float64Var defines a float64 flag with the given name and usage string that sets the Optional that p points to.
+// See [Var] for more information.
+func Float64Var(fs *flag.FlagSet, p *Optional[float64], name string, usage string) {
+	Var(fs, p, name, usage, func(s string) (float64, error) {
+		return strconv.ParseFloat(s, 64)
+	})
+}
+
+// StringVar defines a string flag with the given name and usage string that sets the Optional that p points to.
+// If the flag is present on the command line with an empty value, the Optional is set to an empty string.
+// See [Var] for more information.
+func StringVar(fs *flag.FlagSet, p *Optional[string], name string, usage string) {
+	Var(fs, p, name, usage, func(s string) (string, error) {
+		return s, nil
+	})
+}
+
+// DurationVar defines a [time.Duration] flag with the given name and usage string that sets the Optional that p points to.
+// The flag accepts values acceptable to [time.ParseDuration].
+// See [Var] for more information.
+func DurationVar(fs *flag.FlagSet, p *Optional[time.Duration], name string, usage string) {
+	Var(fs, p, name, usage, time.ParseDuration)
+}
+
+// TextVar defines a flag with the given name and usage string that sets the Optional that p points to.
+// The flag's value is parsed the same way as non-empty text is unmarshalled by [Optional.UnmarshalText];
+// that means T should either implement [encoding.TextUnmarshaler] (through a pointer), be [time.Duration], or have a string, bool, integer or floating point kind.
+// See [Var] for more information.
+func TextVar[T any](fs *flag.FlagSet, p *Optional[T], name string, usage string) {
+	Var(fs, p, name, usage, func(s string) (T, error) {
+		return unmarshalText[T]([]byte(s))
+	})
+}
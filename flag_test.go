@@ -0,0 +1,200 @@
+package optional
+
+import (
+	"flag"
+	"io"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+)
+
+type flagOptions struct {
+	verbose Optional[bool]
+	count   Optional[int]
+	size    Optional[int64]
+	limit   Optional[uint]
+	max     Optional[uint64]
+	ratio   Optional[float64]
+	name    Optional[string]
+	timeout Optional[time.Duration]
+	addr    Optional[netip.Addr]
+	level   Optional[textLevel]
+	words   Optional[[]string]
+}
+
+func newFlagSet(options *flagOptions) *flag.FlagSet {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	BoolVar(fs, &options.verbose, "verbose", "verbose output")
+	IntVar(fs, &options.count, "count", "the count")
+	Int64Var(fs, &options.size, "size", "the size")
+	UintVar(fs, &options.limit, "limit", "the limit")
+	Uint64Var(fs, &options.max, "max", "the max")
+	Float64Var(fs, &options.ratio, "ratio", "the ratio")
+	StringVar(fs, &options.name, "name", "the name")
+	DurationVar(fs, &options.timeout, "timeout", "the timeout")
+	TextVar(fs, &options.addr, "addr", "the address")
+	TextVar(fs, &options.level, "level", "the level")
+	Var(fs, &options.words, "words", "the words", func(s string) ([]string, error) {
+		return strings.Split(s, ","), nil
+	})
+
+	return fs
+}
+
+func TestFlagsNotSet(t *testing.T) {
+	var options flagOptions
+
+	if err := newFlagSet(&options).Parse([]string{}); err != nil {
+		t.Fatalf("Parse should not return an error, was %v", err)
+	}
+
+	if !options.verbose.IsEmpty() || !options.count.IsEmpty() || !options.size.IsEmpty() || !options.limit.IsEmpty() ||
+		!options.max.IsEmpty() || !options.ratio.IsEmpty() || !options.name.IsEmpty() || !options.timeout.IsEmpty() ||
+		!options.addr.IsEmpty() || !options.level.IsEmpty() || !options.words.IsEmpty() {
+
+		t.Errorf("flags that are not set should be empty, was %+v", options)
+	}
+}
+
+func TestFlagsSet(t *testing.T) {
+	var options flagOptions
+
+	args := []string{
+		"-verbose", "-count=0x10", "-size=-1", "-limit=2", "-max=3", "-ratio=0.5", "-name=", "-timeout=0s",
+		"-addr=127.0.0.1", "-level=3", "-words=a,b",
+	}
+
+	if err := newFlagSet(&options).Parse(args); err != nil {
+		t.Fatalf("Parse should not return an error, was %v", err)
+	}
+
+	if options.verbose != Of(true) {
+		t.Errorf("verbose should be optional.Of(true), was %v", options.verbose)
+	}
+
+	if options.count != Of(16) {
+		t.Errorf("count should be optional.Of(16), was %v", options.count)
+	}
+
+	if options.size != Of(int64(-1)) {
+		t.Errorf("size should be optional.Of(-1), was %v", options.size)
+	}
+
+	if options.limit != Of(uint(2)) {
+		t.Errorf("limit should be optional.Of(2), was %v", options.limit)
+	}
+
+	if options.max != Of(uint64(3)) {
+		t.Errorf("max should be optional.Of(3), was %v", options.max)
+	}
+
+	if options.ratio != Of(0.5) {
+		t.Errorf("ratio should be optional.Of(0.5), was %v", options.ratio)
+	}
+
+	if options.name != Of("") {
+		t.Errorf("name should be optional.Of(''), was %v", options.name)
+	}
+
+	if options.timeout != Of(time.Duration(0)) {
+		t.Errorf("timeout should be optional.Of(0s), was %v", options.timeout)
+	}
+
+	if options.addr != Of(netip.MustParseAddr("127.0.0.1")) {
+		t.Errorf("addr should be optional.Of(127.0.0.1), was %v", options.addr)
+	}
+
+	if options.level != Of(textLevel(3)) {
+		t.Errorf("level should be optional.Of(3), was %v", options.level)
+	}
+
+	if words, ok := options.words.Get(); !ok || len(words) != 2 || words[0] != "a" || words[1] != "b" {
+		t.Errorf("words should be optional.Of([a b]), was %v", options.words)
+	}
+}
+
+func TestFlagsWithInvalidValues(t *testing.T) {
+	parameters := []string{
+		"-verbose=foo", "-count=foo", "-size=foo", "-limit=-1", "-max=foo", "-ratio=foo", "-timeout=foo", "-addr=foo", "-level=foo",
+	}
+
+	for i := range parameters {
+		arg := parameters[i]
+
+		t.Run(arg, func(t *testing.T) {
+			var options flagOptions
+
+			if err := newFlagSet(&options).Parse([]string{arg}); err == nil {
+				t.Errorf("Parse(%s) should return an error", arg)
+			}
+		})
+	}
+}
+
+func TestFlagsKeepCurrentValue(t *testing.T) {
+	options := flagOptions{
+		count: Of(5),
+	}
+
+	if err := newFlagSet(&options).Parse([]string{}); err != nil {
+		t.Fatalf("Parse should not return an error, was %v", err)
+	}
+
+	if options.count != Of(5) {
+		t.Errorf("count should be optional.Of(5), was %v", options.count)
+	}
+}
+
+func TestFlagDefaultValues(t *testing.T) {
+	options := flagOptions{
+		count: Of(5),
+	}
+
+	fs := newFlagSet(&options)
+
+	if defValue := fs.Lookup("count").DefValue; defValue != "5" {
+		t.Errorf("default value of count should be '5', was '%s'", defValue)
+	}
+
+	if defValue := fs.Lookup("name").DefValue; defValue != "" {
+		t.Errorf("default value of name should be '', was '%s'", defValue)
+	}
+
+	var builder strings.Builder
+
+	fs.SetOutput(&builder)
+	fs.PrintDefaults()
+
+	if !strings.Contains(builder.String(), "the count (default 5)") {
+		t.Errorf("defaults should contain the default count, was '%s'", builder.String())
+	}
+
+	if strings.Contains(builder.String(), "the name (default") {
+		t.Errorf("defaults should not contain a default name, was '%s'", builder.String())
+	}
+}
+
+func TestFlagGetter(t *testing.T) {
+	var options flagOptions
+
+	fs := newFlagSet(&options)
+	if err := fs.Parse([]string{"-count=1"}); err != nil {
+		t.Fatalf("Parse should not return an error, was %v", err)
+	}
+
+	getter, ok := fs.Lookup("count").Value.(flag.Getter)
+	if !ok {
+		t.Fatalf("flag value should implement flag.Getter")
+	}
+
+	if value := getter.Get(); value != Of(1) {
+		t.Errorf("Get should return optional.Of(1), was %v", value)
+	}
+
+	if s := getter.String(); s != "1" {
+		t.Errorf("String should return '1', was '%s'", s)
+	}
+}
@@ -0,0 +1,44 @@
+package optional
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// Format implements the [fmt.Formatter] interface.
+//
+// An empty Optional is formatted as "Optional.empty".
+// A non-empty Optional is formatted as "Optional[value]", where the value is formatted using the given verb, flags, width and precision.
+// For instance, formatting [Of](3.14159) using %.2f results in "Optional[3.14]", and formatting [Of]("foo") using %q results in `Optional["foo"]`.
+//
+// The %+v verb includes the Optional's generic type: "Optional[int].empty" or "Optional[int][5]".
+// The %#v verb results in the same value as [Optional.GoString].
+func (o Optional[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		_, _ = io.WriteString(f, o.GoString())
+	case verb == 'v' && f.Flag('+'):
+		typeName := reflect.TypeFor[T]().String()
+		if !o.present {
+			_, _ = fmt.Fprintf(f, "Optional[%s].empty", typeName)
+		} else {
+			_, _ = fmt.Fprintf(f, "Optional[%s]["+fmt.FormatString(f, verb)+"]", typeName, o.value)
+		}
+	case !o.present:
+		_, _ = io.WriteString(f, "Optional.empty")
+	default:
+		_, _ = fmt.Fprintf(f, "Optional["+fmt.FormatString(f, verb)+"]", o.value)
+	}
+}
+
+// GoString implements the [fmt.GoStringer] interface.
+// It returns Go source for creating the Optional, for instance "optional.Of[int](5)" or "optional.Empty[int]()".
+func (o Optional[T]) GoString() string {
+	typeName := reflect.TypeFor[T]().String()
+	if !o.present {
+		return fmt.Sprintf("optional.Empty[%s]()", typeName)
+	}
+
+	return fmt.Sprintf("optional.Of[%s](%#v)", typeName, o.value)
+}
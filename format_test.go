@@ -0,0 +1,91 @@
+package optional
+
+import (
+	"fmt"
+	"testing"
+)
+
+type formatPoint struct {
+	X int
+	Y int
+}
+
+func TestFormat(t *testing.T) {
+	parameters := []struct {
+		format   string
+		value    any
+		expected string
+	}{
+		{"%v", Empty[int](), "Optional.empty"},
+		{"%v", Of(5), "Optional[5]"},
+		{"%s", Of("foo"), "Optional[foo]"},
+		{"%d", Empty[int](), "Optional.empty"},
+		{"%d", Of(5), "Optional[5]"},
+		{"%03d", Of(5), "Optional[005]"},
+		{"%x", Of(255), "Optional[ff]"},
+		{"%X", Of("hi"), "Optional[6869]"},
+		{"%.2f", Of(3.14159), "Optional[3.14]"},
+		{"%8.3f", Of(3.14159), "Optional[   3.142]"},
+		{"%-4d|", Of(5), "Optional[5   ]|"},
+		{"%q", Of("foo"), `Optional["foo"]`},
+		{"%q", Empty[string](), "Optional.empty"},
+		{"%t", Of(true), "Optional[true]"},
+		{"%v", Of(formatPoint{X: 1, Y: 2}), "Optional[{1 2}]"},
+		{"%v", Of(Of(1)), "Optional[Optional[1]]"},
+		{"%d", Of(Of(1)), "Optional[Optional[1]]"},
+		{"%+v", Empty[int](), "Optional[int].empty"},
+		{"%+v", Of(5), "Optional[int][5]"},
+		{"%+v", Of(formatPoint{X: 1, Y: 2}), "Optional[optional.formatPoint][{X:1 Y:2}]"},
+		{"%+v", Of(Of("foo")), "Optional[optional.Optional[string]][Optional[string][foo]]"},
+		{"%#v", Empty[int](), "optional.Empty[int]()"},
+		{"%#v", Of(5), "optional.Of[int](5)"},
+		{"%#v", Of("foo"), `optional.Of[string]("foo")`},
+		{"%#v", Of(formatPoint{X: 1, Y: 2}), "optional.Of[optional.formatPoint](optional.formatPoint{X:1, Y:2})"},
+		{"%#v", Of(Empty[int]()), "optional.Of[optional.Optional[int]](optional.Empty[int]())"},
+		{"%T", Of(5), "optional.Optional[int]"},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(fmt.Sprintf("%s %s", parameter.format, parameter.expected), func(t *testing.T) {
+			if s := fmt.Sprintf(parameter.format, parameter.value); s != parameter.expected {
+				t.Errorf("formatting using '%s' should return '%s', was '%s'", parameter.format, parameter.expected, s)
+			}
+		})
+	}
+}
+
+func TestFormatMatchesString(t *testing.T) {
+	parameters := []Optional[int]{Empty[int](), Of(1)}
+
+	for i := range parameters {
+		opt := parameters[i]
+
+		if s := fmt.Sprint(opt); s != opt.String() {
+			t.Errorf("fmt.Sprint should return '%s', was '%s'", opt.String(), s)
+		}
+	}
+}
+
+func TestGoString(t *testing.T) {
+	parameters := []struct {
+		opt      fmt.GoStringer
+		expected string
+	}{
+		{Empty[int](), "optional.Empty[int]()"},
+		{Of(5), "optional.Of[int](5)"},
+		{Of([]string{"a"}), `optional.Of[[]string]([]string{"a"})`},
+		{Empty[error](), "optional.Empty[error]()"},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.expected, func(t *testing.T) {
+			if s := parameter.opt.GoString(); s != parameter.expected {
+				t.Errorf("GoString should return '%s', was '%s'", parameter.expected, s)
+			}
+		})
+	}
+}
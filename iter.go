@@ -0,0 +1,52 @@
+package optional
+
+import (
+	"iter"
+)
+
+// All returns an iterator that yields the value if present, or yields nothing otherwise.
+func (o Optional[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if o.present {
+			yield(o.value)
+		}
+	}
+}
+
+// First returns a non-empty Optional describing the first value yielded by the given iterator, or an empty Optional if it yields no values.
+func First[T any](seq iter.Seq[T]) Optional[T] {
+	for value := range seq {
+		return Of(value)
+	}
+
+	return Empty[T]()
+}
+
+// Last returns a non-empty Optional describing the last value yielded by the given iterator, or an empty Optional if it yields no values.
+func Last[T any](seq iter.Seq[T]) Optional[T] {
+	result := Empty[T]()
+	for value := range seq {
+		result = Of(value)
+	}
+
+	return result
+}
+
+// Find returns a non-empty Optional describing the first value yielded by the given iterator that matches the given predicate,
+// or an empty Optional if no such value is yielded.
+func Find[T any](seq iter.Seq[T], predicate func(value T) bool) Optional[T] {
+	for value := range seq {
+		if predicate(value) {
+			return Of(value)
+		}
+	}
+
+	return Empty[T]()
+}
+
+// Next returns a non-empty Optional describing the next value returned by the given function, or an empty Optional if no value is available.
+//
+// This function is meant to be used with the next function returned by [iter.Pull].
+func Next[T any](next func() (T, bool)) Optional[T] {
+	return OfOk(next())
+}
@@ -0,0 +1,149 @@
+package optional
+
+import (
+	"iter"
+	"slices"
+	"testing"
+)
+
+func TestAllWhenEmpty(t *testing.T) {
+	opt := Empty[string]()
+
+	action := capturingAction[string]{}
+
+	for value := range opt.All() {
+		action.Invoke(value)
+	}
+
+	if len(action.arguments) != 0 {
+		t.Errorf("optional.Empty().All should not yield any values, yielded %v", action.arguments)
+	}
+}
+
+func TestAllWhenPresent(t *testing.T) {
+	opt := Of(1)
+
+	action := capturingAction[int]{}
+
+	for value := range opt.All() {
+		action.Invoke(value)
+	}
+
+	if len(action.arguments) != 1 || action.arguments[0] != 1 {
+		t.Errorf("optional.Of(1).All should yield [1], yielded %v", action.arguments)
+	}
+}
+
+func TestAllWhenPresentWithBreak(t *testing.T) {
+	opt := Of(1)
+
+	for range opt.All() {
+		break
+	}
+}
+
+func TestFirst(t *testing.T) {
+	parameters := []struct {
+		name     string
+		seq      iter.Seq[int]
+		expected Optional[int]
+	}{
+		{"empty", slices.Values([]int{}), Empty[int]()},
+		{"single", slices.Values([]int{1}), Of(1)},
+		{"multiple", slices.Values([]int{1, 2, 3}), Of(1)},
+		{"optional", Of(2).All(), Of(2)},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			result := First(parameter.seq)
+
+			if result != parameter.expected {
+				t.Errorf("First should return %v, was %v", parameter.expected, result)
+			}
+		})
+	}
+}
+
+func TestLast(t *testing.T) {
+	parameters := []struct {
+		name     string
+		seq      iter.Seq[int]
+		expected Optional[int]
+	}{
+		{"empty", slices.Values([]int{}), Empty[int]()},
+		{"single", slices.Values([]int{1}), Of(1)},
+		{"multiple", slices.Values([]int{1, 2, 3}), Of(3)},
+		{"optional", Of(2).All(), Of(2)},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			result := Last(parameter.seq)
+
+			if result != parameter.expected {
+				t.Errorf("Last should return %v, was %v", parameter.expected, result)
+			}
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	isEven := func(value int) bool {
+		return value%2 == 0
+	}
+
+	parameters := []struct {
+		name     string
+		seq      iter.Seq[int]
+		expected Optional[int]
+	}{
+		{"empty", slices.Values([]int{}), Empty[int]()},
+		{"no match", slices.Values([]int{1, 3, 5}), Empty[int]()},
+		{"single match", slices.Values([]int{1, 2, 3}), Of(2)},
+		{"multiple matches", slices.Values([]int{1, 2, 3, 4}), Of(2)},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			result := Find(parameter.seq, isEven)
+
+			if result != parameter.expected {
+				t.Errorf("Find should return %v, was %v", parameter.expected, result)
+			}
+		})
+	}
+}
+
+func TestFindStopsAtFirstMatch(t *testing.T) {
+	predicate := capturingPredicate[int]{result: true}
+
+	result := Find(slices.Values([]int{1, 2, 3}), predicate.Invoke)
+
+	if result != Of(1) {
+		t.Errorf("Find should return optional.Of(1), was %v", result)
+	}
+
+	if len(predicate.arguments) != 1 || predicate.arguments[0] != 1 {
+		t.Errorf("predicate given to Find should be invoked with [1], was %v", predicate.arguments)
+	}
+}
+
+func TestNext(t *testing.T) {
+	next, stop := iter.Pull(slices.Values([]int{1, 2}))
+	defer stop()
+
+	expected := []Optional[int]{Of(1), Of(2), Empty[int](), Empty[int]()}
+
+	for i := range expected {
+		if result := Next(next); result != expected[i] {
+			t.Errorf("call %d to Next should return %v, was %v", i+1, expected[i], result)
+		}
+	}
+}
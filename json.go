@@ -0,0 +1,43 @@
+package optional
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+var jsonNull = []byte("null")
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// An empty Optional is marshalled as null, a non-empty Optional is marshalled as its value.
+//
+// Note that a non-empty Optional containing an empty Optional is also marshalled as null,
+// and will therefore be unmarshalled as an empty Optional.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.present {
+		return jsonNull, nil
+	}
+
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// null is unmarshalled as an empty Optional, any other value is unmarshalled as a non-empty Optional.
+//
+// If a JSON object does not contain a field for an Optional, this method is not called, and the Optional will keep its current value.
+// For newly created values that means it will remain empty.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		*o = Empty[T]()
+
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	*o = Of(value)
+
+	return nil
+}
@@ -0,0 +1,227 @@
+package optional
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type jsonPerson struct {
+	Name    string              `json:"name"`
+	Age     Optional[int]       `json:"age"`
+	Email   Optional[string]    `json:"email"`
+	Address Optional[jsonPlace] `json:"address"`
+}
+
+type jsonPlace struct {
+	City    string           `json:"city"`
+	Country Optional[string] `json:"country"`
+}
+
+func TestMarshalJSONWhenEmpty(t *testing.T) {
+	opt := Empty[int]()
+
+	data, err := json.Marshal(opt)
+	if err != nil {
+		t.Fatalf("json.Marshal(optional.Empty()) should not return an error, was %v", err)
+	}
+
+	expected := "null"
+	if string(data) != expected {
+		t.Errorf("json.Marshal(optional.Empty()) should return '%s', was '%s'", expected, data)
+	}
+}
+
+func TestMarshalJSONWhenPresent(t *testing.T) {
+	opt := Of(1)
+
+	data, err := json.Marshal(opt)
+	if err != nil {
+		t.Fatalf("json.Marshal(optional.Of(1)) should not return an error, was %v", err)
+	}
+
+	expected := "1"
+	if string(data) != expected {
+		t.Errorf("json.Marshal(optional.Of(1)) should return '%s', was '%s'", expected, data)
+	}
+}
+
+func TestMarshalJSONWithNestedOptionals(t *testing.T) {
+	parameters := []struct {
+		name     string
+		opt      Optional[Optional[string]]
+		expected string
+	}{
+		{"empty", Empty[Optional[string]](), "null"},
+		{"present empty", Of(Empty[string]()), "null"},
+		{"present present", Of(Of("foo")), `"foo"`},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			data, err := json.Marshal(parameter.opt)
+			if err != nil {
+				t.Fatalf("json.Marshal(%v) should not return an error, was %v", parameter.opt, err)
+			}
+
+			if string(data) != parameter.expected {
+				t.Errorf("json.Marshal(%v) should return '%s', was '%s'", parameter.opt, parameter.expected, data)
+			}
+		})
+	}
+}
+
+func TestMarshalJSONWithStructs(t *testing.T) {
+	person := jsonPerson{
+		Name: "John",
+		Age:  Of(42),
+		Address: Of(jsonPlace{
+			City: "Amsterdam",
+		}),
+	}
+
+	data, err := json.Marshal(person)
+	if err != nil {
+		t.Fatalf("json.Marshal should not return an error, was %v", err)
+	}
+
+	expected := `{"name":"John","age":42,"email":null,"address":{"city":"Amsterdam","country":null}}`
+	if string(data) != expected {
+		t.Errorf("json.Marshal should return '%s', was '%s'", expected, data)
+	}
+}
+
+func TestMarshalJSONWithMaps(t *testing.T) {
+	opt := Of(map[string]Optional[int]{
+		"a": Of(1),
+		"b": Empty[int](),
+	})
+
+	data, err := json.Marshal(opt)
+	if err != nil {
+		t.Fatalf("json.Marshal should not return an error, was %v", err)
+	}
+
+	expected := `{"a":1,"b":null}`
+	if string(data) != expected {
+		t.Errorf("json.Marshal should return '%s', was '%s'", expected, data)
+	}
+}
+
+func TestUnmarshalJSONWithNull(t *testing.T) {
+	opt := Of(1)
+
+	if err := json.Unmarshal([]byte(" null "), &opt); err != nil {
+		t.Fatalf("json.Unmarshal should not return an error, was %v", err)
+	}
+
+	if !opt.IsEmpty() {
+		t.Errorf("json.Unmarshal with null should result in an empty Optional, was %v", opt)
+	}
+}
+
+func TestUnmarshalJSONWithValue(t *testing.T) {
+	var opt Optional[int]
+
+	if err := json.Unmarshal([]byte("1"), &opt); err != nil {
+		t.Fatalf("json.Unmarshal should not return an error, was %v", err)
+	}
+
+	if !Equal(opt, Of(1)) {
+		t.Errorf("json.Unmarshal with 1 should result in optional.Of(1), was %v", opt)
+	}
+}
+
+func TestUnmarshalJSONWithInvalidValue(t *testing.T) {
+	opt := Of(1)
+
+	if err := json.Unmarshal([]byte(`"foo"`), &opt); err == nil {
+		t.Errorf("json.Unmarshal with a string into an Optional[int] should return an error")
+	}
+
+	if !Equal(opt, Of(1)) {
+		t.Errorf("json.Unmarshal with an invalid value should not modify the Optional, was %v", opt)
+	}
+}
+
+func TestUnmarshalJSONWithStructs(t *testing.T) {
+	var person jsonPerson
+
+	data := `{"name":"John","age":42,"email":null,"address":{"city":"Amsterdam"}}`
+	if err := json.Unmarshal([]byte(data), &person); err != nil {
+		t.Fatalf("json.Unmarshal should not return an error, was %v", err)
+	}
+
+	if person.Name != "John" {
+		t.Errorf("name should be 'John', was '%s'", person.Name)
+	}
+
+	if !Equal(person.Age, Of(42)) {
+		t.Errorf("age should be optional.Of(42), was %v", person.Age)
+	}
+
+	if !person.Email.IsEmpty() {
+		t.Errorf("email should be empty, was %v", person.Email)
+	}
+
+	address, err := person.Address.OrElseError()
+	if err != nil {
+		t.Fatalf("address should be present")
+	}
+
+	if address.City != "Amsterdam" {
+		t.Errorf("address.city should be 'Amsterdam', was '%s'", address.City)
+	}
+
+	if !address.Country.IsEmpty() {
+		t.Errorf("absent address.country should be empty, was %v", address.Country)
+	}
+}
+
+func TestUnmarshalJSONWithNestedOptionals(t *testing.T) {
+	var opt Optional[Optional[string]]
+
+	if err := json.Unmarshal([]byte(`"foo"`), &opt); err != nil {
+		t.Fatalf("json.Unmarshal should not return an error, was %v", err)
+	}
+
+	inner, err := opt.OrElseError()
+	if err != nil {
+		t.Fatalf("outer Optional should be present")
+	}
+
+	if !Equal(inner, Of("foo")) {
+		t.Errorf("inner Optional should be optional.Of('foo'), was %v", inner)
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	original := map[string]Optional[jsonPlace]{
+		"home": Of(jsonPlace{City: "Amsterdam", Country: Of("NL")}),
+		"work": Empty[jsonPlace](),
+	}
+
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("json.Marshal should not return an error, was %v", err)
+	}
+
+	var result map[string]Optional[jsonPlace]
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatalf("json.Unmarshal should not return an error, was %v", err)
+	}
+
+	if len(result) != len(original) {
+		t.Fatalf("round trip should result in %v, was %v", original, result)
+	}
+
+	home := result["home"].OrElse(jsonPlace{})
+	if home.City != "Amsterdam" || !Equal(home.Country, Of("NL")) {
+		t.Errorf("round trip should preserve home, was %v", result["home"])
+	}
+
+	if work, ok := result["work"]; !ok || !work.IsEmpty() {
+		t.Errorf("round trip should preserve work as empty, was %v", result["work"])
+	}
+}
@@ -0,0 +1,212 @@
+package optional
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+type nullableState uint8
+
+const (
+	undefinedState nullableState = iota
+	nullState
+	presentState
+)
+
+// Nullable is a container object that is either undefined, null, or contains a value.
+// It is a companion type of Optional that can be used to distinguish between absent fields and fields that are explicitly set to null,
+// for instance in JSON Merge Patch (RFC 7396) requests.
+//
+// The zero value of Nullable is an undefined Nullable.
+// Combined with the omitzero option of the encoding/json package (Go 1.24 and up), undefined Nullables are omitted when marshalling.
+type Nullable[T any] struct {
+	value T
+	state nullableState
+}
+
+// NullableUndefined returns an undefined Nullable.
+func NullableUndefined[T any]() Nullable[T] {
+	return Nullable[T]{}
+}
+
+// NullableNull returns a null Nullable.
+func NullableNull[T any]() Nullable[T] {
+	return Nullable[T]{state: nullState}
+}
+
+// NullableOf returns a Nullable describing the given value.
+func NullableOf[T any](value T) Nullable[T] {
+	return Nullable[T]{value: value, state: presentState}
+}
+
+// Nullable returns a Nullable describing the value if present, or a null Nullable otherwise.
+func (o Optional[T]) Nullable() Nullable[T] {
+	if !o.present {
+		return NullableNull[T]()
+	}
+
+	return NullableOf(o.value)
+}
+
+// IsUndefined returns true if the Nullable is undefined, or false otherwise.
+func (n Nullable[T]) IsUndefined() bool {
+	return n.state == undefinedState
+}
+
+// IsNull returns true if the Nullable is null, or false otherwise.
+func (n Nullable[T]) IsNull() bool {
+	return n.state == nullState
+}
+
+// IsPresent returns true if a value is present, or false otherwise.
+func (n Nullable[T]) IsPresent() bool {
+	return n.state == presentState
+}
+
+// IsZero returns true if the Nullable is undefined, or false otherwise.
+// This allows undefined Nullables to be omitted using the omitzero option of the encoding/json package.
+func (n Nullable[T]) IsZero() bool {
+	return n.state == undefinedState
+}
+
+// Get returns the value and true if present, or the zero value of T and false otherwise.
+func (n Nullable[T]) Get() (T, bool) {
+	return n.value, n.state == presentState
+}
+
+// OrElse returns the value if present, or the given other value otherwise.
+func (n Nullable[T]) OrElse(other T) T {
+	if n.state == presentState {
+		return n.value
+	}
+
+	return other
+}
+
+// Optional returns a non-empty Optional containing the value if present, or an empty Optional otherwise.
+func (n Nullable[T]) Optional() Optional[T] {
+	if n.state != presentState {
+		return Empty[T]()
+	}
+
+	return Of(n.value)
+}
+
+// String implements the [fmt.Stringer] interface.
+func (n Nullable[T]) String() string {
+	switch n.state {
+	case nullState:
+		return "Nullable.null"
+	case presentState:
+		return fmt.Sprintf("Nullable[%v]", n.value)
+	default:
+		return "Nullable.undefined"
+	}
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// A null or undefined Nullable is marshalled as null, a Nullable with a value is marshalled as its value.
+//
+// To omit undefined Nullables, use the omitzero option.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if n.state != presentState {
+		return jsonNull, nil
+	}
+
+	return json.Marshal(n.value)
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// null is unmarshalled as a null Nullable, any other value is unmarshalled as a Nullable with a value.
+//
+// If a JSON object does not contain a field for a Nullable, this method is not called, and the Nullable will keep its current value.
+// For newly created values that means it will remain undefined.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		*n = NullableNull[T]()
+
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	*n = NullableOf(value)
+
+	return nil
+}
+
+var errInvalidPatchArguments = errors.New("target must be a non-nil pointer to a struct, and patch must be a struct or a non-nil pointer to a struct")
+
+type patchField interface {
+	applyTo(target reflect.Value) error
+}
+
+// ApplyPatch applies the Nullable fields of the given patch onto the fields with the same name of the given target.
+// The target must be a non-nil pointer to a struct; the patch must be a struct or a non-nil pointer to a struct.
+//
+// For each exported field of the patch of a Nullable type:
+//   - If the Nullable is undefined, the target's field is left unchanged.
+//   - If the Nullable is null, the target's field is set to its zero value.
+//     For Optional fields that means they become empty, and for pointer fields that means they become nil.
+//   - If the Nullable has a value, the target's field is set to that value.
+//     The target's field can be of the same type as the value, an Optional or Nullable of that type, or a pointer to that type.
+//
+// Fields of the patch that are not of a Nullable type are ignored. Nested structs are not patched recursively.
+// An error is returned if the target does not have a matching field, or if the field's type is not compatible.
+func ApplyPatch(target any, patch any) error {
+	return walkStructs(target, patch, errInvalidPatchArguments, func(targetValue reflect.Value, field reflect.StructField, patchValue reflect.Value) error {
+		patchField, ok := patchValue.Interface().(patchField)
+		if !ok {
+			return nil
+		}
+
+		targetField, err := settableField(targetValue, field.Name, "target")
+		if err != nil {
+			return err
+		}
+
+		if err := patchField.applyTo(targetField); err != nil {
+			return fmt.Errorf("cannot patch field %s: %w", field.Name, err)
+		}
+
+		return nil
+	})
+}
+
+func (n Nullable[T]) applyTo(target reflect.Value) error {
+	targetType := target.Type()
+
+	switch {
+	case targetType == reflect.TypeFor[Nullable[T]]():
+		if n.state != undefinedState {
+			target.Set(reflect.ValueOf(n))
+		}
+	case targetType != reflect.TypeFor[T]() && targetType != reflect.TypeFor[Optional[T]]() && targetType != reflect.TypeFor[*T]():
+		return fmt.Errorf("cannot assign %s to %s", reflect.TypeFor[Nullable[T]](), targetType)
+	case n.state == nullState:
+		target.SetZero()
+	case n.state == presentState:
+		target.Set(n.reflectValue(targetType))
+	}
+
+	return nil
+}
+
+func (n Nullable[T]) reflectValue(targetType reflect.Type) reflect.Value {
+	switch targetType {
+	case reflect.TypeFor[Optional[T]]():
+		return reflect.ValueOf(Of(n.value))
+	case reflect.TypeFor[*T]():
+		value := n.value
+
+		return reflect.ValueOf(&value)
+	default:
+		return reflect.ValueOf(&n.value).Elem()
+	}
+}
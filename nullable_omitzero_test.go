@@ -0,0 +1,28 @@
+//go:build go1.24
+
+package optional
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// The omitzero option of the encoding/json package is only supported as of Go 1.24.
+
+func TestNullableMarshalJSON(t *testing.T) {
+	patch := nullablePatch{
+		Name:    NullableOf("John"),
+		Age:     NullableNull[int](),
+		Version: 1,
+	}
+
+	data, err := json.Marshal(patch)
+	if err != nil {
+		t.Fatalf("json.Marshal should not return an error, was %v", err)
+	}
+
+	expected := `{"name":"John","age":null,"version":1}`
+	if string(data) != expected {
+		t.Errorf("json.Marshal should return '%s', was '%s'", expected, data)
+	}
+}
@@ -0,0 +1,358 @@
+package optional
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type nullablePatch struct {
+	Name     Nullable[string] `json:"name,omitzero"`
+	Age      Nullable[int]    `json:"age,omitzero"`
+	Email    Nullable[string] `json:"email,omitzero"`
+	Nickname Nullable[string] `json:"nickname,omitzero"`
+	Phone    Nullable[string] `json:"phone,omitzero"`
+	Version  int              `json:"version"`
+}
+
+type nullableTarget struct {
+	Name     string
+	Age      Optional[int]
+	Email    *string
+	Nickname Nullable[string]
+	Phone    Optional[string]
+	Version  int
+}
+
+func TestDefaultNullableIsUndefined(t *testing.T) {
+	var n Nullable[string]
+
+	if !n.IsUndefined() || n.IsNull() || n.IsPresent() {
+		t.Error("default Nullable should be undefined")
+	}
+}
+
+func TestNullableUndefined(t *testing.T) {
+	n := NullableUndefined[string]()
+
+	if !n.IsUndefined() || n.IsNull() || n.IsPresent() {
+		t.Error("optional.NullableUndefined() should be undefined")
+	}
+
+	if !n.IsZero() {
+		t.Error("optional.NullableUndefined() should be zero")
+	}
+}
+
+func TestNullableNull(t *testing.T) {
+	n := NullableNull[string]()
+
+	if n.IsUndefined() || !n.IsNull() || n.IsPresent() {
+		t.Error("optional.NullableNull() should be null")
+	}
+
+	if n.IsZero() {
+		t.Error("optional.NullableNull() should not be zero")
+	}
+}
+
+func TestNullableOf(t *testing.T) {
+	n := NullableOf("")
+
+	if n.IsUndefined() || n.IsNull() || !n.IsPresent() {
+		t.Error("optional.NullableOf('') should be present")
+	}
+
+	if n.IsZero() {
+		t.Error("optional.NullableOf('') should not be zero")
+	}
+}
+
+func TestNullableGet(t *testing.T) {
+	parameters := []struct {
+		n             Nullable[int]
+		expected      int
+		expectedFound bool
+	}{
+		{NullableUndefined[int](), 0, false},
+		{NullableNull[int](), 0, false},
+		{NullableOf(1), 1, true},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.n.String(), func(t *testing.T) {
+			value, ok := parameter.n.Get()
+
+			if value != parameter.expected || ok != parameter.expectedFound {
+				t.Errorf("Get should return (%v, %v), was (%v, %v)", parameter.expected, parameter.expectedFound, value, ok)
+			}
+		})
+	}
+}
+
+func TestNullableOrElse(t *testing.T) {
+	parameters := []struct {
+		n        Nullable[int]
+		expected int
+	}{
+		{NullableUndefined[int](), 2},
+		{NullableNull[int](), 2},
+		{NullableOf(1), 1},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.n.String(), func(t *testing.T) {
+			if value := parameter.n.OrElse(2); value != parameter.expected {
+				t.Errorf("OrElse(2) should return %v, was %v", parameter.expected, value)
+			}
+		})
+	}
+}
+
+func TestNullableOptional(t *testing.T) {
+	parameters := []struct {
+		n        Nullable[int]
+		expected Optional[int]
+	}{
+		{NullableUndefined[int](), Empty[int]()},
+		{NullableNull[int](), Empty[int]()},
+		{NullableOf(1), Of(1)},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.n.String(), func(t *testing.T) {
+			if opt := parameter.n.Optional(); opt != parameter.expected {
+				t.Errorf("Optional should return %v, was %v", parameter.expected, opt)
+			}
+		})
+	}
+}
+
+func TestOptionalNullable(t *testing.T) {
+	if n := Empty[int]().Nullable(); n != NullableNull[int]() {
+		t.Errorf("optional.Empty().Nullable should return optional.NullableNull(), was %v", n)
+	}
+
+	if n := Of(1).Nullable(); n != NullableOf(1) {
+		t.Errorf("optional.Of(1).Nullable should return optional.NullableOf(1), was %v", n)
+	}
+}
+
+func TestNullableString(t *testing.T) {
+	parameters := []struct {
+		n        Nullable[int]
+		expected string
+	}{
+		{NullableUndefined[int](), "Nullable.undefined"},
+		{NullableNull[int](), "Nullable.null"},
+		{NullableOf(1), "Nullable[1]"},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.expected, func(t *testing.T) {
+			if s := parameter.n.String(); s != parameter.expected {
+				t.Errorf("String should return '%s', was '%s'", parameter.expected, s)
+			}
+		})
+	}
+}
+
+func TestNullableMarshalJSONWithoutOmitZero(t *testing.T) {
+	data, err := json.Marshal(NullableUndefined[int]())
+	if err != nil {
+		t.Fatalf("json.Marshal should not return an error, was %v", err)
+	}
+
+	if string(data) != "null" {
+		t.Errorf("json.Marshal should return 'null', was '%s'", data)
+	}
+}
+
+func TestNullableUnmarshalJSON(t *testing.T) {
+	var patch nullablePatch
+
+	if err := json.Unmarshal([]byte(`{"name":"John","age":null,"email":""}`), &patch); err != nil {
+		t.Fatalf("json.Unmarshal should not return an error, was %v", err)
+	}
+
+	if patch.Name != NullableOf("John") {
+		t.Errorf("name should be optional.NullableOf('John'), was %v", patch.Name)
+	}
+
+	if patch.Age != NullableNull[int]() {
+		t.Errorf("age should be optional.NullableNull(), was %v", patch.Age)
+	}
+
+	if patch.Email != NullableOf("") {
+		t.Errorf("email should be optional.NullableOf(''), was %v", patch.Email)
+	}
+
+	if patch.Nickname != NullableUndefined[string]() {
+		t.Errorf("nickname should be optional.NullableUndefined(), was %v", patch.Nickname)
+	}
+}
+
+func TestNullableUnmarshalJSONWithInvalidValue(t *testing.T) {
+	n := NullableOf(1)
+
+	if err := json.Unmarshal([]byte(`"foo"`), &n); err == nil {
+		t.Errorf("json.Unmarshal with a string into a Nullable[int] should return an error")
+	}
+
+	if n != NullableOf(1) {
+		t.Errorf("json.Unmarshal with an invalid value should not modify the Nullable, was %v", n)
+	}
+}
+
+func TestApplyPatch(t *testing.T) {
+	email := "john@example.org"
+	target := nullableTarget{
+		Name:     "John",
+		Age:      Of(42),
+		Email:    &email,
+		Nickname: NullableOf("Johnny"),
+		Phone:    Of("12345"),
+		Version:  1,
+	}
+
+	var patch nullablePatch
+	if err := json.Unmarshal([]byte(`{"name":"Jane","age":null,"email":"jane@example.org","nickname":null,"version":2}`), &patch); err != nil {
+		t.Fatalf("json.Unmarshal should not return an error, was %v", err)
+	}
+
+	if err := ApplyPatch(&target, patch); err != nil {
+		t.Fatalf("ApplyPatch should not return an error, was %v", err)
+	}
+
+	if target.Name != "Jane" {
+		t.Errorf("name should be 'Jane', was '%s'", target.Name)
+	}
+
+	if !target.Age.IsEmpty() {
+		t.Errorf("age should be empty, was %v", target.Age)
+	}
+
+	if target.Email == nil || *target.Email != "jane@example.org" || target.Email == &email {
+		t.Errorf("email should be a new pointer to 'jane@example.org', was %v", target.Email)
+	}
+
+	if target.Nickname != NullableNull[string]() {
+		t.Errorf("nickname should be optional.NullableNull(), was %v", target.Nickname)
+	}
+
+	if target.Phone != Of("12345") {
+		t.Errorf("phone should be unchanged, was %v", target.Phone)
+	}
+
+	if target.Version != 1 {
+		t.Errorf("version should be unchanged, was %v", target.Version)
+	}
+}
+
+func TestApplyPatchWithNulls(t *testing.T) {
+	email := "john@example.org"
+	target := nullableTarget{
+		Name:  "John",
+		Age:   Of(42),
+		Email: &email,
+	}
+
+	patch := &nullablePatch{
+		Name:  NullableNull[string](),
+		Email: NullableNull[string](),
+	}
+
+	if err := ApplyPatch(&target, patch); err != nil {
+		t.Fatalf("ApplyPatch should not return an error, was %v", err)
+	}
+
+	if target.Name != "" {
+		t.Errorf("name should be empty, was '%s'", target.Name)
+	}
+
+	if target.Age != Of(42) {
+		t.Errorf("age should be unchanged, was %v", target.Age)
+	}
+
+	if target.Email != nil {
+		t.Errorf("email should be nil, was %v", *target.Email)
+	}
+}
+
+func TestApplyPatchWithPresentOptional(t *testing.T) {
+	target := nullableTarget{}
+
+	patch := nullablePatch{Age: NullableOf(1)}
+
+	if err := ApplyPatch(&target, patch); err != nil {
+		t.Fatalf("ApplyPatch should not return an error, was %v", err)
+	}
+
+	if target.Age != Of(1) {
+		t.Errorf("age should be optional.Of(1), was %v", target.Age)
+	}
+}
+
+func TestApplyPatchWithInvalidArguments(t *testing.T) {
+	target := nullableTarget{}
+
+	parameters := []struct {
+		name   string
+		target any
+		patch  any
+	}{
+		{"nil target", nil, nullablePatch{}},
+		{"non-pointer target", target, nullablePatch{}},
+		{"nil pointer target", (*nullableTarget)(nil), nullablePatch{}},
+		{"pointer to non-struct target", new(int), nullablePatch{}},
+		{"nil patch", &target, nil},
+		{"nil pointer patch", &target, (*nullablePatch)(nil)},
+		{"non-struct patch", &target, 1},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			if err := ApplyPatch(parameter.target, parameter.patch); err == nil {
+				t.Errorf("ApplyPatch should return an error")
+			}
+		})
+	}
+}
+
+func TestApplyPatchWithMissingField(t *testing.T) {
+	target := struct {
+		Name string
+	}{}
+
+	patch := struct {
+		Name  Nullable[string]
+		Other Nullable[string]
+	}{}
+
+	if err := ApplyPatch(&target, patch); err == nil {
+		t.Errorf("ApplyPatch with a missing target field should return an error")
+	}
+}
+
+func TestApplyPatchWithIncompatibleField(t *testing.T) {
+	target := struct {
+		Name int
+	}{}
+
+	patch := struct {
+		Name Nullable[string]
+	}{}
+
+	if err := ApplyPatch(&target, patch); err == nil {
+		t.Errorf("ApplyPatch with an incompatible target field should return an error")
+	}
+}
@@ -1,60 +1,162 @@
 package optional
 
 import (
-	"errors"
 	"fmt"
-	"log"
+	"reflect"
 )
 
-const noValuePresentMessage = "no value present"
-
-var errNoValuePresent = errors.New(noValuePresentMessage)
-
 // Optional is a container object that may or may not contain a value.
 // If no value is present, the object is considered empty.
+//
+// The zero value of Optional is an empty Optional.
+//
+// If T is comparable, Optional[T] is comparable as well, and Optionals can be compared using ==.
+// Two Optionals are equal if both are empty, or if both have equal values.
+// This makes it possible to use Optionals as map keys, in switch statements, or with functions like [slices.Contains].
+//
+// Empty Optionals do not carry a reason for being empty; use [Optional.Because] to turn an Optional into a [Result] with a reason.
 type Optional[T any] struct {
-	value *T
+	value   T
+	present bool
 }
 
 // Empty returns an empty Optional.
 func Empty[T any]() Optional[T] {
-	return Optional[T]{value: nil}
+	return Optional[T]{}
 }
 
 // Of returns a non-empty Optional describing the given value.
 func Of[T any](value T) Optional[T] {
-	return OfNillable(&value)
+	return Optional[T]{value: value, present: true}
 }
 
 // OfNillable returns a non-empty Optional describing the target of the given pointer if it's not nil,
 // or an empty Optional otherwise.
+//
+// The returned Optional contains a copy of the pointer's target; subsequent changes to the target are not reflected in the Optional.
+// This copy is a shallow copy; use [OfClone] to create deep copies.
 func OfNillable[T any](value *T) Optional[T] {
-	return Optional[T]{value: value}
+	if value == nil {
+		return Empty[T]()
+	}
+
+	return Of(*value)
+}
+
+// OfNonZero returns a non-empty Optional describing the given value if it's not the zero value of T, or an empty Optional otherwise.
+func OfNonZero[T comparable](value T) Optional[T] {
+	var zero T
+	if value == zero {
+		return Empty[T]()
+	}
+
+	return Of(value)
+}
+
+// OfNonNil returns a non-empty Optional describing the given value if it's not nil, or an empty Optional otherwise.
+//
+// Unlike [OfNillable], this function checks the value itself for nil, using reflection.
+// A value is nil if it's a nil pointer, interface, map, slice, function, channel or unsafe pointer,
+// or an interface containing any of these that is nil. Empty but non-nil maps and slices are not nil.
+func OfNonNil[T any](value T) Optional[T] {
+	if isNil(reflect.ValueOf(&value).Elem()) {
+		return Empty[T]()
+	}
+
+	return Of(value)
+}
+
+func isNil(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Interface:
+		return value.IsNil() || isNil(value.Elem())
+	case reflect.Chan, reflect.Func, reflect.Map, reflect.Pointer, reflect.Slice, reflect.UnsafePointer:
+		return value.IsNil()
+	default:
+		return false
+	}
+}
+
+// OfOk returns a non-empty Optional describing the given value if ok is true, or an empty Optional otherwise.
+//
+// This function can be used to wrap the results of functions that follow the comma-ok idiom, like [os.LookupEnv]:
+//
+//	opt := optional.OfOk(os.LookupEnv("HOME"))
+func OfOk[T any](value T, ok bool) Optional[T] {
+	if !ok {
+		return Empty[T]()
+	}
+
+	return Of(value)
+}
+
+// OfResult returns a non-empty Optional describing the given value if the given error is nil,
+// or an empty Optional otherwise.
+// Use [FromPair] instead to keep the error.
+//
+// This function can be used to wrap calls to functions that return a value and an error:
+//
+//	opt := optional.OfResult(strconv.Atoi(s))
+func OfResult[T any](value T, err error) Optional[T] {
+	if err != nil {
+		return Empty[T]()
+	}
+
+	return Of(value)
+}
+
+// Lookup returns a non-empty Optional describing the value for the given key in the given map if it exists, or an empty Optional otherwise.
+func Lookup[K comparable, V any](m map[K]V, key K) Optional[V] {
+	value, ok := m[key]
+
+	return OfOk(value, ok)
 }
 
-// Omit Get, require one of the OrElse methods instead
+// Get returns the value and true if present, or the zero value of T and false otherwise.
+//
+// Unlike Java's Optional.get, this method does not panic if no value is present.
+// Instead it follows the comma-ok idiom of map lookups and type assertions.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.present
+}
 
 // IsPresent returns true if a value is present, or false otherwise.
 func (o Optional[T]) IsPresent() bool {
-	return o.value != nil
+	return o.present
 }
 
 // IsEmpty returns true if no value is present, or false otherwise.
 func (o Optional[T]) IsEmpty() bool {
-	return o.value == nil
+	return !o.present
+}
+
+// Because returns a successful Result containing the value if present,
+// or a failed Result containing a [*NoValueError] with the given reason as cause otherwise.
+// The error wraps both [ErrNoValuePresent] and the reason.
+//
+// This can be used to record why the result of operations like [Optional.Filter], [Optional.MapNillable] and [Optional.FlatMap] is empty,
+// and to continue with a [Result] pipeline that propagates that reason:
+//
+//	value, err := opt.Filter(isValid).Because(errInvalid).Get()
+func (o Optional[T]) Because(reason error) Result[T] {
+	if !o.present {
+		return Err[T](newNoValueError[T](reason))
+	}
+
+	return Ok(o.value)
 }
 
 // IfPresent calls the given action with the value if present, or does nothing otherwise.
 func (o Optional[T]) IfPresent(action func(value T)) {
-	if o.value != nil {
-		action(*o.value)
+	if o.present {
+		action(o.value)
 	}
 }
 
 // IfPresentOrElse calls the given action with the value if present, or calls the given empty-based action otherwise.
 func (o Optional[T]) IfPresentOrElse(action func(value T), emptyAction func()) {
-	if o.value != nil {
-		action(*o.value)
+	if o.present {
+		action(o.value)
 	} else {
 		emptyAction()
 	}
@@ -62,7 +164,7 @@ func (o Optional[T]) IfPresentOrElse(action func(value T), emptyAction func()) {
 
 // Filter returns a non-empty Optional if a value is present and it matches the given predicate, or an empty Optional otherwise.
 func (o Optional[T]) Filter(predicate func(value T) bool) Optional[T] {
-	if o.value == nil || predicate(*o.value) {
+	if !o.present || predicate(o.value) {
 		return o
 	}
 
@@ -74,22 +176,22 @@ func (o Optional[T]) Filter(predicate func(value T) bool) Optional[T] {
 // Due to the limitations of generics in Go, the mapper function must return the Optional's generic type.
 // The [Map] function can be used to map to different types.
 func (o Optional[T]) Map(mapper func(value T) T) Optional[T] {
-	if o.value == nil {
+	if !o.present {
 		return o
 	}
 
-	return Of(mapper(*o.value))
+	return Of(mapper(o.value))
 }
 
 // Map returns a non-empty Optional containing the result of calling the given mapper function on the given Optional's value if present, or an empty Optional otherwise.
 //
 // This function can be used where the generic type of the Optional and the mapper function's return type do not match.
 func Map[T any, U any](optional Optional[T], mapper func(value T) U) Optional[U] {
-	if optional.value == nil {
+	if !optional.present {
 		return Empty[U]()
 	}
 
-	return Of(mapper(*optional.value))
+	return Of(mapper(optional.value))
 }
 
 // MapNillable returns a possibly empty Optional (as if by [OfNillable]) based on the result of calling the given mapper function on the value if present,
@@ -98,11 +200,11 @@ func Map[T any, U any](optional Optional[T], mapper func(value T) U) Optional[U]
 // Due to the limitations of generics in Go, the mapper function must return a pointer to the Optional's generic type.
 // The [MapNillable] function can be used to map to different types.
 func (o Optional[T]) MapNillable(mapper func(value T) *T) Optional[T] {
-	if o.value == nil {
+	if !o.present {
 		return o
 	}
 
-	return OfNillable(mapper(*o.value))
+	return OfNillable(mapper(o.value))
 }
 
 // MapNillable returns a possibly empty Optional (as if by [OfNillable]) based on the result of calling the given mapper function on the given Optional's value if present,
@@ -110,11 +212,11 @@ func (o Optional[T]) MapNillable(mapper func(value T) *T) Optional[T] {
 //
 // This function can be used where the generic type of the Optional and the mapper function's return type do not match.
 func MapNillable[T any, U any](optional Optional[T], mapper func(value T) *U) Optional[U] {
-	if optional.value == nil {
+	if !optional.present {
 		return Empty[U]()
 	}
 
-	return OfNillable(mapper(*optional.value))
+	return OfNillable(mapper(optional.value))
 }
 
 // FlatMap returns the result of applying the given function if the value is present, or an empty Optional otherwise.
@@ -122,27 +224,27 @@ func MapNillable[T any, U any](optional Optional[T], mapper func(value T) *U) Op
 // Due to the limitations of generics in Go, the mapper function must return the Optional's exact type.
 // The [FlatMap] function can be used to map to different types.
 func (o Optional[T]) FlatMap(mapper func(value T) Optional[T]) Optional[T] {
-	if o.value == nil {
+	if !o.present {
 		return o
 	}
 
-	return mapper(*o.value)
+	return mapper(o.value)
 }
 
 // FlatMap returns the result of applying the given function if the given Optional's value is present, or an empty Optional otherwise.
 //
 // This function can be used where the generic type of the Optional and the mapper function's return type do not match.
 func FlatMap[T any, U any](optional Optional[T], mapper func(value T) Optional[U]) Optional[U] {
-	if optional.value == nil {
+	if !optional.present {
 		return Empty[U]()
 	}
 
-	return mapper(*optional.value)
+	return mapper(optional.value)
 }
 
 // Or returns the Optional if the value is present, or the result of calling the given function otherwise.
 func (o Optional[T]) Or(supplier func() Optional[T]) Optional[T] {
-	if o.value != nil {
+	if o.present {
 		return o
 	}
 
@@ -152,8 +254,8 @@ func (o Optional[T]) Or(supplier func() Optional[T]) Optional[T] {
 // Slice returns a slice containing the value if present, or an empty slice otherwise.
 func (o Optional[T]) Slice() []T {
 	var result []T
-	if o.value != nil {
-		result = append(result, *o.value)
+	if o.present {
+		result = append(result, o.value)
 	}
 
 	return result
@@ -161,8 +263,8 @@ func (o Optional[T]) Slice() []T {
 
 // OrElse returns the value if present, or the given other value otherwise.
 func (o Optional[T]) OrElse(other T) T {
-	if o.value != nil {
-		return *o.value
+	if o.present {
+		return o.value
 	}
 
 	return other
@@ -170,62 +272,60 @@ func (o Optional[T]) OrElse(other T) T {
 
 // OrElseGet returns the value if present, or the result of calling the given function otherwise.
 func (o Optional[T]) OrElseGet(supplier func() T) T {
-	if o.value != nil {
-		return *o.value
+	if o.present {
+		return o.value
 	}
 
 	return supplier()
 }
 
-// OrElsePanic returns the value if present, or panics otherwise.
+// OrElsePanic returns the value if present, or panics with a [*NoValueError] otherwise.
 func (o Optional[T]) OrElsePanic() T {
-	if o.value == nil {
-		log.Panic(noValuePresentMessage)
+	if !o.present {
+		panic(newNoValueError[T](nil))
 	}
 
-	return *o.value
+	return o.value
 }
 
-// OrElseError returns the value if present. If the Optional is empty it will return a non-nil error.
+// OrElseError returns the value if present. If the Optional is empty it will return a non-nil [*NoValueError].
+// Use [Optional.Because] to return an error with a specific reason.
 func (o Optional[T]) OrElseError() (T, error) {
-	if o.value == nil {
+	if !o.present {
 		var zero T
 
-		return zero, errNoValuePresent
+		return zero, newNoValueError[T](nil)
 	}
 
-	return *o.value, nil
+	return o.value, nil
 }
 
 // OrElseSupplyError returns the value if present. If the Optional is empty it will return an error returned by the given supplier.
 func (o Optional[T]) OrElseSupplyError(errorSupplier func() error) (T, error) {
-	if o.value == nil {
+	if !o.present {
 		var zero T
 
 		return zero, errorSupplier()
 	}
 
-	return *o.value, nil
+	return o.value, nil
 }
 
 // String implements the [fmt.Stringer] interface.
 func (o Optional[T]) String() string {
-	if o.value == nil {
+	if !o.present {
 		return "Optional.empty"
 	}
 
-	return fmt.Sprintf("Optional[%v]", *o.value)
+	return fmt.Sprintf("Optional[%v]", o.value)
 }
 
 // Equal compares two Optional objects. It will return true if both Optionals are empty, or if both Optionals have equal values.
+// For Optionals of comparable types this is the same as comparing them using ==.
 func Equal[T comparable](opt Optional[T], other Optional[T]) bool {
-	if opt.value == nil && other.value == nil {
-		return true
-	}
-
-	if opt.value == nil || other.value == nil {
-		return false
+	if !opt.present || !other.present {
+		return opt.present == other.present
 	}
 
-	return *opt.value == *other.value
+	return opt.value == other.value
 }
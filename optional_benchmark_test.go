@@ -0,0 +1,119 @@
+package optional
+
+import (
+	"testing"
+)
+
+var (
+	benchmarkOptional Optional[int]
+	benchmarkValue    int
+)
+
+func increment(value int) int {
+	return value + 1
+}
+
+func isEven(value int) bool {
+	return value%2 == 0
+}
+
+func TestZeroAllocations(t *testing.T) {
+	parameters := []struct {
+		name string
+		f    func()
+	}{
+		{"Of", func() { benchmarkOptional = Of(1) }},
+		{"Map", func() { benchmarkOptional = Of(1).Map(increment) }},
+		{"Global Map", func() { benchmarkOptional = Map(Of(1), increment) }},
+		{"Filter", func() { benchmarkOptional = Of(1).Filter(isEven) }},
+		{"OrElse", func() { benchmarkValue = Of(1).OrElse(2) }},
+		{"Empty OrElse", func() { benchmarkValue = Empty[int]().OrElse(2) }},
+		{"Get", func() { benchmarkValue, _ = Of(1).Get() }},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			allocs := testing.AllocsPerRun(100, parameter.f)
+
+			if allocs != 0 {
+				t.Errorf("%s should not allocate, #allocations: %v", parameter.name, allocs)
+			}
+		})
+	}
+}
+
+func BenchmarkOf(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := range b.N {
+		benchmarkOptional = Of(i)
+	}
+}
+
+func BenchmarkOfNillable(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := range b.N {
+		benchmarkOptional = OfNillable(&i)
+	}
+}
+
+func BenchmarkMap(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := range b.N {
+		benchmarkOptional = Of(i).Map(increment)
+	}
+}
+
+func BenchmarkGlobalMap(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := range b.N {
+		benchmarkOptional = Map(Of(i), increment)
+	}
+}
+
+func BenchmarkFlatMap(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := range b.N {
+		benchmarkOptional = Of(i).FlatMap(func(value int) Optional[int] {
+			return Of(value + 1)
+		})
+	}
+}
+
+func BenchmarkFilter(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := range b.N {
+		benchmarkOptional = Of(i).Filter(isEven)
+	}
+}
+
+func BenchmarkOrElse(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := range b.N {
+		benchmarkValue = Of(i).OrElse(0)
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := range b.N {
+		benchmarkValue, _ = Of(i).Get()
+	}
+}
+
+func BenchmarkOrElseWhenEmpty(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := range b.N {
+		benchmarkValue = Empty[int]().OrElse(i)
+	}
+}
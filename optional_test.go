@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
+	"strconv"
 	"testing"
 )
 
@@ -68,6 +70,251 @@ func TestOfNillableWithNonNil(t *testing.T) {
 	}
 }
 
+func TestOfNillableCopiesValue(t *testing.T) {
+	v := 1
+	opt := OfNillable(&v)
+
+	v = 2
+
+	if value := opt.OrElse(0); value != 1 {
+		t.Errorf("optional.OfNillable(*1) should not be affected by changes to the pointer's target, was %v", value)
+	}
+}
+
+func TestBecauseWhenEmpty(t *testing.T) {
+	result := Empty[int]().Because(io.EOF)
+
+	if !result.IsErr() {
+		t.Fatalf("optional.Empty().Because(io.EOF) should return a failed Result, was %v", result)
+	}
+
+	err := result.Err()
+
+	if !errors.Is(err, ErrNoValuePresent) || !errors.Is(err, io.EOF) {
+		t.Errorf("optional.Empty().Because(io.EOF) should return a Result with an error matching ErrNoValuePresent and io.EOF, was %v", err)
+	}
+
+	var noValueError *NoValueError
+	if !errors.As(err, &noValueError) || noValueError.Type != "int" || !errors.Is(noValueError.Cause, io.EOF) {
+		t.Errorf("optional.Empty().Because(io.EOF) should return a Result with a *NoValueError with type 'int' and cause io.EOF, was %v", err)
+	}
+}
+
+func TestBecauseWhenPresent(t *testing.T) {
+	if result := Of(1).Because(io.EOF); result != Ok(1) {
+		t.Errorf("optional.Of(1).Because(io.EOF) should return optional.Ok(1), was %v", result)
+	}
+}
+
+func TestBecauseReasonPropagation(t *testing.T) {
+	errNotFound := errors.New("not found")
+
+	result := MapResult(Of("foo").Filter(func(string) bool { return false }).Because(errNotFound), func(value string) int {
+		return len(value)
+	})
+
+	_, err := result.Get()
+
+	expectedMessage := "no value present: not found"
+
+	if err == nil || err.Error() != expectedMessage {
+		t.Errorf("Get should return an error with message '%v', was %v", expectedMessage, err)
+	}
+
+	if !errors.Is(err, ErrNoValuePresent) || !errors.Is(err, errNotFound) {
+		t.Errorf("Get should return an error matching ErrNoValuePresent and the reason, was %v", err)
+	}
+}
+
+func TestOfNonZero(t *testing.T) {
+	if opt := OfNonZero(0); opt != Empty[int]() {
+		t.Errorf("optional.OfNonZero(0) should return optional.Empty(), was %v", opt)
+	}
+
+	if opt := OfNonZero(1); opt != Of(1) {
+		t.Errorf("optional.OfNonZero(1) should return optional.Of(1), was %v", opt)
+	}
+
+	if opt := OfNonZero(""); opt != Empty[string]() {
+		t.Errorf("optional.OfNonZero('') should return optional.Empty(), was %v", opt)
+	}
+
+	if opt := OfNonZero[error](nil); opt != Empty[error]() {
+		t.Errorf("optional.OfNonZero(nil) should return optional.Empty(), was %v", opt)
+	}
+
+	if opt := OfNonZero[error](io.EOF); opt != Of[error](io.EOF) {
+		t.Errorf("optional.OfNonZero(io.EOF) should return optional.Of(io.EOF), was %v", opt)
+	}
+}
+
+func TestOfNonNil(t *testing.T) {
+	var nilPointer *int
+
+	value := 1
+
+	t.Run("nil interface", func(t *testing.T) {
+		testOfNonNil[error](t, nil, false)
+	})
+	t.Run("non-nil interface", func(t *testing.T) {
+		testOfNonNil[error](t, io.EOF, true)
+	})
+	t.Run("interface containing nil pointer", func(t *testing.T) {
+		testOfNonNil[any](t, nilPointer, false)
+	})
+	t.Run("interface containing nil map", func(t *testing.T) {
+		testOfNonNil[any](t, map[string]int(nil), false)
+	})
+	t.Run("interface containing non-nil pointer", func(t *testing.T) {
+		testOfNonNil[any](t, &value, true)
+	})
+	t.Run("interface containing zero value", func(t *testing.T) {
+		testOfNonNil[any](t, 0, true)
+	})
+	t.Run("nil pointer", func(t *testing.T) {
+		testOfNonNil(t, nilPointer, false)
+	})
+	t.Run("non-nil pointer", func(t *testing.T) {
+		testOfNonNil(t, &value, true)
+	})
+	t.Run("nil map", func(t *testing.T) {
+		testOfNonNil[map[string]int](t, nil, false)
+	})
+	t.Run("empty map", func(t *testing.T) {
+		testOfNonNil(t, map[string]int{}, true)
+	})
+	t.Run("nil slice", func(t *testing.T) {
+		testOfNonNil[[]int](t, nil, false)
+	})
+	t.Run("empty slice", func(t *testing.T) {
+		testOfNonNil(t, []int{}, true)
+	})
+	t.Run("nil func", func(t *testing.T) {
+		testOfNonNil[func()](t, nil, false)
+	})
+	t.Run("non-nil func", func(t *testing.T) {
+		testOfNonNil(t, func() {}, true)
+	})
+	t.Run("nil chan", func(t *testing.T) {
+		testOfNonNil[chan int](t, nil, false)
+	})
+	t.Run("non-nil chan", func(t *testing.T) {
+		testOfNonNil(t, make(chan int), true)
+	})
+	t.Run("zero int", func(t *testing.T) {
+		testOfNonNil(t, 0, true)
+	})
+	t.Run("zero struct", func(t *testing.T) {
+		testOfNonNil(t, struct{}{}, true)
+	})
+}
+
+func testOfNonNil[T any](t *testing.T, value T, expectPresent bool) {
+	t.Helper()
+
+	opt := OfNonNil(value)
+
+	if opt.IsPresent() != expectPresent {
+		t.Errorf("optional.OfNonNil(%v).IsPresent should return %v", value, expectPresent)
+	}
+}
+
+func TestOfOk(t *testing.T) {
+	if opt := OfOk(1, true); opt != Of(1) {
+		t.Errorf("optional.OfOk(1, true) should return optional.Of(1), was %v", opt)
+	}
+
+	if opt := OfOk(1, false); opt != Empty[int]() {
+		t.Errorf("optional.OfOk(1, false) should return optional.Empty(), was %v", opt)
+	}
+}
+
+func TestOfOkWithTypeAssertion(t *testing.T) {
+	var value any = "foo"
+
+	s, ok := value.(string)
+	if opt := OfOk(s, ok); opt != Of("foo") {
+		t.Errorf("optional.OfOk with a successful type assertion should return optional.Of('foo'), was %v", opt)
+	}
+
+	i, ok := value.(int)
+	if opt := OfOk(i, ok); opt != Empty[int]() {
+		t.Errorf("optional.OfOk with a failed type assertion should return optional.Empty(), was %v", opt)
+	}
+}
+
+func TestOfResult(t *testing.T) {
+	if opt := OfResult(1, nil); opt != Of(1) {
+		t.Errorf("optional.OfResult(1, nil) should return optional.Of(1), was %v", opt)
+	}
+
+	if opt := OfResult(1, io.EOF); opt != Empty[int]() {
+		t.Errorf("optional.OfResult(1, io.EOF) should return optional.Empty(), was %v", opt)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	m := map[string]int{
+		"zero": 0,
+		"one":  1,
+	}
+
+	parameters := []struct {
+		key      string
+		expected Optional[int]
+	}{
+		{"zero", Of(0)},
+		{"one", Of(1)},
+		{"two", Empty[int]()},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.key, func(t *testing.T) {
+			if opt := Lookup(m, parameter.key); opt != parameter.expected {
+				t.Errorf("Lookup should return %v, was %v", parameter.expected, opt)
+			}
+		})
+	}
+}
+
+func TestLookupWithNilMap(t *testing.T) {
+	var m map[string]int
+
+	if opt := Lookup(m, "foo"); opt != Empty[int]() {
+		t.Errorf("Lookup with a nil map should return optional.Empty(), was %v", opt)
+	}
+}
+
+func TestGetWhenEmpty(t *testing.T) {
+	opt := Empty[string]()
+
+	value, ok := opt.Get()
+
+	if ok {
+		t.Error("optional.Empty().Get should return false")
+	}
+
+	if value != "" {
+		t.Errorf("optional.Empty().Get should return an empty string, was %v", value)
+	}
+}
+
+func TestGetWhenPresent(t *testing.T) {
+	opt := Of(1)
+
+	value, ok := opt.Get()
+
+	if !ok {
+		t.Error("optional.Of(1).Get should return true")
+	}
+
+	if value != 1 {
+		t.Errorf("optional.Of(1).Get should return 1, was %v", value)
+	}
+}
+
 func TestIfPresentWhenEmpty(t *testing.T) {
 	opt := Empty[string]()
 
@@ -213,8 +460,8 @@ func TestMapWhenPresent(t *testing.T) {
 		t.Errorf("optional.Of(1).Map should not return an empty Optional")
 	}
 
-	if *mapped.value != 2 {
-		t.Errorf("optional.Of(2).Map should return an Optional with value 2, was %v", *mapped.value)
+	if mapped.value != 2 {
+		t.Errorf("optional.Of(2).Map should return an Optional with value 2, was %v", mapped.value)
 	}
 
 	if len(mapper.arguments) != 1 || mapper.arguments[0] != 1 {
@@ -249,8 +496,8 @@ func TestGlobalMapWhenPresent(t *testing.T) {
 		t.Errorf("Map called with optional.Of(1) should not return an empty Optional")
 	}
 
-	if *mapped.value != "foo" {
-		t.Errorf("Map called with optional.Of(2) should return an Optional with value 'foo', was %v", *mapped.value)
+	if mapped.value != "foo" {
+		t.Errorf("Map called with optional.Of(2) should return an Optional with value 'foo', was %v", mapped.value)
 	}
 
 	if len(mapper.arguments) != 1 || mapper.arguments[0] != 1 {
@@ -311,8 +558,8 @@ func TestMapNillableWhenPresentReturningNonNil(t *testing.T) {
 		t.Errorf("optional.Of(1).MapNillable should not return an empty Optional if mapper returns non-nil")
 	}
 
-	if *mapped.value != 2 {
-		t.Errorf("optional.Of(2).MapNillable should return an Optional with value 2, was %v", *mapped.value)
+	if mapped.value != 2 {
+		t.Errorf("optional.Of(2).MapNillable should return an Optional with value 2, was %v", mapped.value)
 	}
 
 	if len(mapper.arguments) != 1 || mapper.arguments[0] != 1 {
@@ -373,8 +620,8 @@ func TestGlobalMapNillableWhenPresentReturningNonNil(t *testing.T) {
 		t.Errorf("MapNillable called with optional.Of(1) should not return an empty Optional if mapper returns non-nil")
 	}
 
-	if *mapped.value != "foo" {
-		t.Errorf("MapNillable called with optional.Of(2) should return an Optional with value 2, was %v", *mapped.value)
+	if mapped.value != "foo" {
+		t.Errorf("MapNillable called with optional.Of(2) should return an Optional with value 2, was %v", mapped.value)
 	}
 
 	if len(mapper.arguments) != 1 || mapper.arguments[0] != 1 {
@@ -425,8 +672,8 @@ func TestFlatMapWhenPresentReturningPresent(t *testing.T) {
 		t.Errorf("optional.Of(1).FlatMap should not return an empty Optional")
 	}
 
-	if *mapped.value != 2 {
-		t.Errorf("optional.Of(2).FlatMap should return an Optional with value 2, was %v", *mapped.value)
+	if mapped.value != 2 {
+		t.Errorf("optional.Of(2).FlatMap should return an Optional with value 2, was %v", mapped.value)
 	}
 
 	if len(mapper.arguments) != 1 || mapper.arguments[0] != 1 {
@@ -477,8 +724,8 @@ func TestGlobalFlatMapWhenPresentReturningPresent(t *testing.T) {
 		t.Errorf("FlatMap called with optional.Of(1) should not return an empty Optional")
 	}
 
-	if *mapped.value != "foo" {
-		t.Errorf("FlatMap called with optional.Of(2) should return an Optional with value 'foo', was %v", *mapped.value)
+	if mapped.value != "foo" {
+		t.Errorf("FlatMap called with optional.Of(2) should return an Optional with value 'foo', was %v", mapped.value)
 	}
 
 	if len(mapper.arguments) != 1 || mapper.arguments[0] != 1 {
@@ -513,8 +760,8 @@ func TestOrWhenEmptyReturningPresent(t *testing.T) {
 		t.Errorf("optional.Empty().Or should not return an empty Optional")
 	}
 
-	if *result.value != "foo" {
-		t.Errorf("optional.Empty().Or should return an Optional with value 'foo', was %v", *result.value)
+	if result.value != "foo" {
+		t.Errorf("optional.Empty().Or should return an Optional with value 'foo', was %v", result.value)
 	}
 
 	if supplier.invocations == 0 {
@@ -538,8 +785,8 @@ func TestOrWhenPresent(t *testing.T) {
 			t.Errorf("optional.Of(1).Or should not return an empty Optional")
 		}
 
-		if *opt2.value != 1 {
-			t.Errorf("optional.Of(2).Or should return an Optional with value 2, was %v", *opt2.value)
+		if opt2.value != 1 {
+			t.Errorf("optional.Of(2).Or should return an Optional with value 2, was %v", opt2.value)
 		}
 
 		if supplier.invocations != 0 {
@@ -629,8 +876,16 @@ func TestOrElsePanicWhenEmpty(t *testing.T) {
 		r := recover()
 		if r == nil {
 			t.Errorf("expected '%v', actual: nil", expectedMessage)
-		} else if s, ok := r.(string); !ok || s != expectedMessage {
+		} else if err, ok := r.(*NoValueError); !ok || err.Error() != expectedMessage {
 			t.Errorf("expected '%v', actual: %v", expectedMessage, r)
+		} else {
+			if !errors.Is(err, ErrNoValuePresent) {
+				t.Errorf("expected %v to match ErrNoValuePresent", err)
+			}
+
+			if err.Type != "string" {
+				t.Errorf("expected type 'string', actual: '%v'", err.Type)
+			}
 		}
 	}()
 
@@ -663,6 +918,15 @@ func TestOrElseErrorWhenEmpty(t *testing.T) {
 	if err == nil || err.Error() != expectedMessage {
 		t.Errorf("optional.Empty().OrElseError should return an error with message '%v', was %v", expectedMessage, err)
 	}
+
+	if !errors.Is(err, ErrNoValuePresent) {
+		t.Errorf("optional.Empty().OrElseError should return an error matching ErrNoValuePresent, was %v", err)
+	}
+
+	var noValueError *NoValueError
+	if !errors.As(err, &noValueError) || noValueError.Type != "string" {
+		t.Errorf("optional.Empty().OrElseError should return a *NoValueError with type 'string', was %v", err)
+	}
 }
 
 func TestOrElseErrorWhenPresent(t *testing.T) {
@@ -786,6 +1050,100 @@ func TestEqualWithPresentWithUnequalValues(t *testing.T) {
 	}
 }
 
+func TestEqualityOperator(t *testing.T) {
+	parameters := []struct {
+		opt1     Optional[int]
+		opt2     Optional[int]
+		expected bool
+	}{
+		{Empty[int](), Empty[int](), true},
+		{Empty[int](), Optional[int]{}, true},
+		{Empty[int](), Of(0), false},
+		{Of(0), Empty[int](), false},
+		{Of(1), Of(1), true},
+		{Of(1), Of(2), false},
+		{Of(1).Filter(func(int) bool { return false }), Empty[int](), true},
+		{OfNillable[int](nil), Empty[int](), true},
+		{OfResult(strconv.Atoi("x")), Empty[int](), true},
+		{Err[int](io.EOF).Optional(), Empty[int](), true},
+		{Err[int](io.EOF).Optional(), OfResult(0, io.ErrUnexpectedEOF), true},
+		{ZipWith(Empty[int](), Of(1), func(a int, b int) int { return a + b }), Empty[int](), true},
+		{tryMapOptional(TryMap(Of("x"), strconv.Atoi)), Empty[int](), true},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(fmt.Sprintf("%v == %v", parameter.opt1, parameter.opt2), func(t *testing.T) {
+			if result := parameter.opt1 == parameter.opt2; result != parameter.expected {
+				t.Errorf("%v == %v should return %v, was %v", parameter.opt1, parameter.opt2, parameter.expected, result)
+			}
+
+			if result := Equal(parameter.opt1, parameter.opt2); result != parameter.expected {
+				t.Errorf("Equal(%v, %v) should return %v, was %v", parameter.opt1, parameter.opt2, parameter.expected, result)
+			}
+		})
+	}
+}
+
+func tryMapOptional[T any](opt Optional[T], _ error) Optional[T] {
+	return opt
+}
+
+func TestOptionalAsMapKey(t *testing.T) {
+	m := map[Optional[string]]int{
+		Empty[string](): 0,
+		Of("foo"):       1,
+	}
+
+	if value, ok := m[Of("foo")]; !ok || value != 1 {
+		t.Errorf("map lookup with optional.Of('foo') should return 1, was %v", value)
+	}
+
+	if value, ok := m[Empty[string]()]; !ok || value != 0 {
+		t.Errorf("map lookup with optional.Empty() should return 0, was %v", value)
+	}
+
+	if value, ok := m[Err[string](io.EOF).Optional()]; !ok || value != 0 {
+		t.Errorf("map lookup with optional.Err(io.EOF).Optional() should return 0, was %v", value)
+	}
+
+	if _, ok := m[Of("bar")]; ok {
+		t.Errorf("map lookup with optional.Of('bar') should not return a value")
+	}
+}
+
+func TestOptionalInSwitch(t *testing.T) {
+	opt := Map(Of("foo"), func(value string) int {
+		return len(value)
+	})
+
+	switch opt {
+	case Empty[int]():
+		t.Errorf("%v should not match optional.Empty()", opt)
+	case Of(3):
+		// expected
+	default:
+		t.Errorf("%v should match optional.Of(3)", opt)
+	}
+}
+
+func TestOptionalInSlices(t *testing.T) {
+	s := []Optional[int]{Of(1), Empty[int](), Of(2)}
+
+	if !slices.Contains(s, Of(2)) {
+		t.Errorf("%v should contain optional.Of(2)", s)
+	}
+
+	if slices.Contains(s, Of(3)) {
+		t.Errorf("%v should not contain optional.Of(3)", s)
+	}
+
+	if index := slices.Index(s, Empty[int]()); index != 1 {
+		t.Errorf("index of optional.Empty() in %v should be 1, was %v", s, index)
+	}
+}
+
 type capturingAction[T any] struct {
 	arguments []T
 }
@@ -0,0 +1,97 @@
+package optional
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var errInvalidConvertArguments = errors.New("dst must be a non-nil pointer to a struct, and src must be a struct or a non-nil pointer to a struct")
+
+// PtrOf returns a pointer to a copy of the given value.
+// It can be used to create pointers to literals and function results, for instance for structs that use pointers for optional fields.
+func PtrOf[T any](value T) *T {
+	return &value
+}
+
+// reflectOptional provides reflection-based access to Optionals.
+type reflectOptional interface {
+	elemType() reflect.Type
+	reflectPtr() reflect.Value
+}
+
+// reflectOptionalTarget provides reflection-based modification of Optionals.
+type reflectOptionalTarget interface {
+	reflectOptional
+	setReflectPtr(pointer reflect.Value)
+}
+
+func (o Optional[T]) elemType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (o Optional[T]) reflectPtr() reflect.Value {
+	return reflect.ValueOf(o.Ptr())
+}
+
+func (o *Optional[T]) setReflectPtr(pointer reflect.Value) {
+	value, _ := pointer.Interface().(*T)
+
+	*o = OfClone(value)
+}
+
+// ConvertStruct copies the exported fields of src to the fields with the same name of dst, converting between pointers and Optionals.
+// The dst must be a non-nil pointer to a struct; the src must be a struct or a non-nil pointer to a struct.
+//
+// For each exported field of src:
+//   - If the field has the same type as the field of dst, it is copied as-is.
+//   - If the field is of type *T and the field of dst is of type Optional[T], the Optional is set as if by [OfClone].
+//   - If the field is of type Optional[T] and the field of dst is of type *T, the pointer is set as if by [Optional.Ptr].
+//
+// Converted fields get their own copy of the value, so the pointer itself is never shared with src.
+// If T implements [Cloner] that copy is a deep copy; otherwise slices, maps and pointers inside the value are still shared.
+// Fields that are copied as-is, including pointer fields of the same type, are shared with src.
+//
+// This allows converting between structs that use pointers for optional fields, like those used by ORMs or generated code,
+// and structs that use Optionals instead, in both directions.
+// Nested structs are not converted recursively.
+// An error is returned if dst does not have a matching field, or if the field's type is not compatible.
+func ConvertStruct(dst any, src any) error {
+	return walkStructs(dst, src, errInvalidConvertArguments, func(dstValue reflect.Value, field reflect.StructField, srcField reflect.Value) error {
+		dstField, err := settableField(dstValue, field.Name, "dst")
+		if err != nil {
+			return err
+		}
+
+		if err := convertField(dstField, srcField); err != nil {
+			return fmt.Errorf("cannot convert field %s: %w", field.Name, err)
+		}
+
+		return nil
+	})
+}
+
+func convertField(dst reflect.Value, src reflect.Value) error {
+	dstType := dst.Type()
+	srcType := src.Type()
+
+	if dstType == srcType {
+		dst.Set(src)
+
+		return nil
+	}
+
+	if srcOptional, ok := src.Interface().(reflectOptional); ok && dstType == reflect.PointerTo(srcOptional.elemType()) {
+		dst.Set(srcOptional.reflectPtr())
+
+		return nil
+	}
+
+	if dstOptional, ok := dst.Addr().Interface().(reflectOptionalTarget); ok && srcType == reflect.PointerTo(dstOptional.elemType()) {
+		dstOptional.setReflectPtr(src)
+
+		return nil
+	}
+
+	return fmt.Errorf("cannot convert %s to %s", srcType, dstType)
+}
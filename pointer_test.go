@@ -0,0 +1,253 @@
+package optional
+
+import (
+	"testing"
+	"time"
+)
+
+type pointerRecord struct {
+	ID      int
+	Name    *string
+	Age     *int
+	Created *time.Time
+	Tags    []string
+	Parent  *pointerRecord
+	secret  string
+}
+
+type optionalRecord struct {
+	ID      int
+	Name    Optional[string]
+	Age     Optional[int]
+	Created Optional[time.Time]
+	Tags    []string
+	Parent  *pointerRecord
+	Extra   Optional[string]
+}
+
+func TestPtrOf(t *testing.T) {
+	value := 1
+
+	p := PtrOf(value)
+	if p == nil || *p != 1 {
+		t.Fatalf("PtrOf(1) should return a pointer to 1, was %v", p)
+	}
+
+	if p == &value {
+		t.Error("PtrOf should return a pointer to a copy")
+	}
+
+	if s := PtrOf("foo"); *s != "foo" {
+		t.Errorf("PtrOf('foo') should return a pointer to 'foo', was %v", *s)
+	}
+}
+
+func TestPtrRoundTrip(t *testing.T) {
+	if opt := OfNillable(Of(1).Ptr()); opt != Of(1) {
+		t.Errorf("OfNillable(optional.Of(1).Ptr()) should return optional.Of(1), was %v", opt)
+	}
+
+	if opt := OfNillable(Empty[int]().Ptr()); opt != Empty[int]() {
+		t.Errorf("OfNillable(optional.Empty().Ptr()) should return optional.Empty(), was %v", opt)
+	}
+}
+
+func TestConvertStructFromPointers(t *testing.T) {
+	now := time.Now()
+	parent := &pointerRecord{ID: 0}
+	src := pointerRecord{
+		ID:      1,
+		Name:    PtrOf("John"),
+		Created: &now,
+		Tags:    []string{"a"},
+		Parent:  parent,
+		secret:  "secret",
+	}
+
+	var dst optionalRecord
+	if err := ConvertStruct(&dst, src); err != nil {
+		t.Fatalf("ConvertStruct should not return an error, was %v", err)
+	}
+
+	if dst.ID != 1 {
+		t.Errorf("id should be 1, was %v", dst.ID)
+	}
+
+	if dst.Name != Of("John") {
+		t.Errorf("name should be optional.Of('John'), was %v", dst.Name)
+	}
+
+	if dst.Age != Empty[int]() {
+		t.Errorf("age should be empty, was %v", dst.Age)
+	}
+
+	if dst.Created != Of(now) {
+		t.Errorf("created should be optional.Of(%v), was %v", now, dst.Created)
+	}
+
+	if len(dst.Tags) != 1 || dst.Tags[0] != "a" {
+		t.Errorf("tags should be [a], was %v", dst.Tags)
+	}
+
+	if dst.Parent != parent {
+		t.Errorf("parent should be copied as-is, was %v", dst.Parent)
+	}
+
+	*src.Name = "Jane"
+
+	if dst.Name != Of("John") {
+		t.Errorf("name should not be affected by changes to the source, was %v", dst.Name)
+	}
+}
+
+func TestConvertStructToPointers(t *testing.T) {
+	src := &optionalRecord{
+		ID:   1,
+		Name: Of("John"),
+		Age:  Of(0),
+	}
+
+	dst := pointerRecord{
+		Created: PtrOf(time.Now()),
+	}
+
+	err := ConvertStruct(&dst, src)
+	if err == nil {
+		t.Fatal("ConvertStruct should return an error for field Extra")
+	}
+
+	src2 := struct {
+		ID      int
+		Name    Optional[string]
+		Age     Optional[int]
+		Created Optional[time.Time]
+	}{
+		ID:   1,
+		Name: Of("John"),
+		Age:  Of(0),
+	}
+
+	if err := ConvertStruct(&dst, src2); err != nil {
+		t.Fatalf("ConvertStruct should not return an error, was %v", err)
+	}
+
+	if dst.ID != 1 {
+		t.Errorf("id should be 1, was %v", dst.ID)
+	}
+
+	if dst.Name == nil || *dst.Name != "John" {
+		t.Errorf("name should be a pointer to 'John', was %v", dst.Name)
+	}
+
+	if dst.Age == nil || *dst.Age != 0 {
+		t.Errorf("age should be a pointer to 0, was %v", dst.Age)
+	}
+
+	if dst.Created != nil {
+		t.Errorf("created should be nil, was %v", *dst.Created)
+	}
+}
+
+func TestConvertStructWithCloner(t *testing.T) {
+	type pointers struct {
+		Tags *cloneTags
+	}
+
+	type optionals struct {
+		Tags Optional[cloneTags]
+	}
+
+	src := optionals{Tags: Of(cloneTags{values: []string{"a"}})}
+
+	var dst pointers
+	if err := ConvertStruct(&dst, src); err != nil {
+		t.Fatalf("ConvertStruct should not return an error, was %v", err)
+	}
+
+	dst.Tags.values[0] = "b"
+
+	if value, _ := src.Tags.Get(); value.values[0] != "a" {
+		t.Errorf("src should not be affected by changes to dst, was %v", value)
+	}
+
+	var dst2 optionals
+	if err := ConvertStruct(&dst2, dst); err != nil {
+		t.Fatalf("ConvertStruct should not return an error, was %v", err)
+	}
+
+	dst.Tags.values[0] = "c"
+
+	if value, _ := dst2.Tags.Get(); value.values[0] != "b" {
+		t.Errorf("dst should not be affected by changes to src, was %v", value)
+	}
+}
+
+func TestConvertStructWithInterfaceTypes(t *testing.T) {
+	type pointers struct {
+		Value *any
+	}
+
+	type optionals struct {
+		Value Optional[any]
+	}
+
+	var dst optionals
+	if err := ConvertStruct(&dst, pointers{Value: PtrOf[any](nil)}); err != nil {
+		t.Fatalf("ConvertStruct should not return an error, was %v", err)
+	}
+
+	if dst.Value != Of[any](nil) {
+		t.Errorf("value should be optional.Of(nil), was %v", dst.Value)
+	}
+
+	var dst2 pointers
+	if err := ConvertStruct(&dst2, optionals{Value: Of[any](1)}); err != nil {
+		t.Fatalf("ConvertStruct should not return an error, was %v", err)
+	}
+
+	if dst2.Value == nil || *dst2.Value != 1 {
+		t.Errorf("value should be a pointer to 1, was %v", dst2.Value)
+	}
+}
+
+func TestConvertStructWithIncompatibleField(t *testing.T) {
+	src := struct {
+		Name *int
+	}{}
+
+	dst := struct {
+		Name Optional[string]
+	}{}
+
+	if err := ConvertStruct(&dst, src); err == nil {
+		t.Error("ConvertStruct with an incompatible field should return an error")
+	}
+}
+
+func TestConvertStructWithInvalidArguments(t *testing.T) {
+	var dst optionalRecord
+
+	parameters := []struct {
+		name string
+		dst  any
+		src  any
+	}{
+		{"nil dst", nil, pointerRecord{}},
+		{"non-pointer dst", dst, pointerRecord{}},
+		{"nil pointer dst", (*optionalRecord)(nil), pointerRecord{}},
+		{"pointer to non-struct dst", new(int), pointerRecord{}},
+		{"nil src", &dst, nil},
+		{"nil pointer src", &dst, (*pointerRecord)(nil)},
+		{"non-struct src", &dst, 1},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			if err := ConvertStruct(parameter.dst, parameter.src); err == nil {
+				t.Error("ConvertStruct should return an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package optional
+
+import (
+	"fmt"
+)
+
+// anyOptional is implemented by all Optional types, and provides non-generic access to the Optional's value.
+type anyOptional interface {
+	anyValue() (any, bool)
+}
+
+func (o Optional[T]) anyValue() (any, bool) {
+	return o.value, o.present
+}
+
+// unwrapAny returns the value and whether or not it's present if the given value is an Optional.
+// Otherwise it returns the value itself, which is considered present if it's not nil.
+func unwrapAny(value any) (any, bool) {
+	if opt, ok := value.(anyOptional); ok {
+		return opt.anyValue()
+	}
+
+	return value, value != nil
+}
+
+// Printer formats Optionals using configurable texts, as an alternative to the fixed format of [Optional.String].
+// The zero value formats empty Optionals as an empty string, and non-empty Optionals as their bare value.
+//
+// Printers can be used from templates by making them available as part of the data or through a function map:
+//
+//	{{ .Printer.Sprint .Field }}
+type Printer struct {
+	// Empty is the text to use for empty Optionals.
+	Empty string
+	// Present is the format to use for non-empty Optionals, as used by [fmt.Sprintf].
+	// It should contain exactly one verb, for the value. If Present is empty, %v is used.
+	Present string
+}
+
+// Sprint formats the given value.
+// If the value is an Optional, it is formatted according to the Printer's configuration.
+// If the value is nil, it is formatted as an empty Optional.
+// Any other value is formatted as a non-empty Optional containing that value.
+func (p Printer) Sprint(value any) string {
+	value, ok := unwrapAny(value)
+	if !ok {
+		return p.Empty
+	}
+
+	format := p.Present
+	if format == "" {
+		format = "%v"
+	}
+
+	return fmt.Sprintf(format, value)
+}
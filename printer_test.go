@@ -0,0 +1,87 @@
+package optional
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+)
+
+func TestPrinterSprint(t *testing.T) {
+	parameters := []struct {
+		name     string
+		printer  Printer
+		value    any
+		expected string
+	}{
+		{"zero printer with empty", Printer{}, Empty[int](), ""},
+		{"zero printer with present", Printer{}, Of(1), "1"},
+		{"zero printer with nil", Printer{}, nil, ""},
+		{"zero printer with non-Optional", Printer{}, 1, "1"},
+		{"custom printer with empty", Printer{Empty: "<none>", Present: "<%v>"}, Empty[string](), "<none>"},
+		{"custom printer with present", Printer{Empty: "<none>", Present: "<%v>"}, Of("foo"), "<foo>"},
+		{"custom printer with verb", Printer{Present: "%.2f"}, Of(3.14159), "3.14"},
+		{"custom printer with quote", Printer{Present: "%q"}, Of("foo"), `"foo"`},
+		{"custom printer with nested", Printer{Empty: "-"}, Of(Of(1)), "Optional[1]"},
+		{"java printer with empty", Printer{Empty: "Optional.empty", Present: "Optional[%v]"}, Empty[int](), Empty[int]().String()},
+		{"java printer with present", Printer{Empty: "Optional.empty", Present: "Optional[%v]"}, Of(1), Of(1).String()},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			if s := parameter.printer.Sprint(parameter.value); s != parameter.expected {
+				t.Errorf("Sprint should return '%s', was '%s'", parameter.expected, s)
+			}
+		})
+	}
+}
+
+func TestPrinterInTemplate(t *testing.T) {
+	tmpl := template.Must(template.New("test").Parse(`{{ .Printer.Sprint .Name }}, {{ .Printer.Sprint .Email }}`))
+
+	data := struct {
+		Printer Printer
+		Name    Optional[string]
+		Email   Optional[string]
+	}{
+		Printer: Printer{Empty: "n/a"},
+		Name:    Of("John"),
+		Email:   Empty[string](),
+	}
+
+	var builder strings.Builder
+	if err := tmpl.Execute(&builder, data); err != nil {
+		t.Fatalf("Execute should not return an error, was %v", err)
+	}
+
+	expected := "John, n/a"
+	if builder.String() != expected {
+		t.Errorf("template should render '%s', was '%s'", expected, builder.String())
+	}
+}
+
+func TestPrinterAsTemplateFunc(t *testing.T) {
+	printer := Printer{Empty: "<none>"}
+
+	tmpl := template.Must(template.New("test").Funcs(template.FuncMap{
+		"opt": printer.Sprint,
+	}).Parse(`{{ opt .Name }}, {{ opt .Age }}`))
+
+	data := struct {
+		Name Optional[string]
+		Age  Optional[int]
+	}{
+		Name: Of("John"),
+	}
+
+	var builder strings.Builder
+	if err := tmpl.Execute(&builder, data); err != nil {
+		t.Fatalf("Execute should not return an error, was %v", err)
+	}
+
+	expected := "John, <none>"
+	if builder.String() != expected {
+		t.Errorf("template should render '%s', was '%s'", expected, builder.String())
+	}
+}
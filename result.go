@@ -0,0 +1,182 @@
+package optional
+
+import (
+	"fmt"
+)
+
+// Result is a container object that contains either a value or an error.
+// It is a companion type of Optional for pipelines that are based on functions that return a value and an error.
+//
+// The zero value of Result is a successful Result containing the zero value of T.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok returns a successful Result containing the given value.
+func Ok[T any](value T) Result[T] {
+	return Result[T]{value: value}
+}
+
+// Err returns a failed Result containing the given error.
+//
+// If the given error is nil, the failed Result contains a [*NoValueError] instead,
+// the same error that [Optional.OrElseError] returns for empty Optionals.
+func Err[T any](err error) Result[T] {
+	if err == nil {
+		err = newNoValueError[T](nil)
+	}
+
+	return Result[T]{err: err}
+}
+
+// FromPair returns a failed Result containing the given error if it's not nil, or a successful Result containing the given value otherwise.
+//
+// This function can be used to wrap calls to functions that return a value and an error:
+//
+//	result := optional.FromPair(strconv.Atoi(s))
+func FromPair[T any](value T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+
+	return Ok(value)
+}
+
+// FromOptional returns a successful Result containing the given Optional's value if present,
+// or a failed Result containing the error returned by the given supplier otherwise.
+//
+// If the supplier returns nil, the failed Result contains a [*NoValueError] instead (as if by [Err]).
+func FromOptional[T any](optional Optional[T], errorSupplier func() error) Result[T] {
+	if !optional.present {
+		return Err[T](errorSupplier())
+	}
+
+	return Ok(optional.value)
+}
+
+// Get returns the value and a nil error if the Result is successful, or the zero value of T and the error otherwise.
+func (r Result[T]) Get() (T, error) {
+	return r.value, r.err
+}
+
+// Err returns the error if the Result is failed, or nil otherwise.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// IsOk returns true if the Result is successful, or false otherwise.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the Result is failed, or false otherwise.
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Filter returns the Result if it's failed or its value matches the given predicate,
+// or a failed Result containing the error returned by calling the given function on the value otherwise.
+// If that function returns nil, the failed Result contains a [*NoValueError] instead (as if by [Err]).
+func (r Result[T]) Filter(predicate func(value T) bool, errorSupplier func(value T) error) Result[T] {
+	if r.err != nil || predicate(r.value) {
+		return r
+	}
+
+	return Err[T](errorSupplier(r.value))
+}
+
+// Map returns a successful Result containing the result of calling the given mapper function on the value if the Result is successful,
+// or the Result itself otherwise.
+//
+// Due to the limitations of generics in Go, the mapper function must return the Result's generic type.
+// The [MapResult] function can be used to map to different types.
+func (r Result[T]) Map(mapper func(value T) T) Result[T] {
+	if r.err != nil {
+		return r
+	}
+
+	return Ok(mapper(r.value))
+}
+
+// MapResult returns a successful Result containing the result of calling the given mapper function on the given Result's value if it's successful,
+// or a failed Result containing the given Result's error otherwise.
+//
+// This function can be used where the generic type of the Result and the mapper function's return type do not match.
+func MapResult[T any, U any](result Result[T], mapper func(value T) U) Result[U] {
+	if result.err != nil {
+		return Err[U](result.err)
+	}
+
+	return Ok(mapper(result.value))
+}
+
+// FlatMap returns the result of applying the given function if the Result is successful, or the Result itself otherwise.
+//
+// Due to the limitations of generics in Go, the mapper function must return the Result's exact type.
+// The [FlatMapResult] function can be used to map to different types.
+func (r Result[T]) FlatMap(mapper func(value T) Result[T]) Result[T] {
+	if r.err != nil {
+		return r
+	}
+
+	return mapper(r.value)
+}
+
+// FlatMapResult returns the result of applying the given function if the given Result is successful,
+// or a failed Result containing the given Result's error otherwise.
+//
+// This function can be used where the generic type of the Result and the mapper function's return type do not match.
+func FlatMapResult[T any, U any](result Result[T], mapper func(value T) Result[U]) Result[U] {
+	if result.err != nil {
+		return Err[U](result.err)
+	}
+
+	return mapper(result.value)
+}
+
+// Or returns the Result if it's successful, or the result of calling the given function with the error otherwise.
+func (r Result[T]) Or(supplier func(err error) Result[T]) Result[T] {
+	if r.err == nil {
+		return r
+	}
+
+	return supplier(r.err)
+}
+
+// OrElse returns the value if the Result is successful, or the given other value otherwise.
+func (r Result[T]) OrElse(other T) T {
+	if r.err == nil {
+		return r.value
+	}
+
+	return other
+}
+
+// OrElseGet returns the value if the Result is successful, or the result of calling the given function with the error otherwise.
+func (r Result[T]) OrElseGet(supplier func(err error) T) T {
+	if r.err == nil {
+		return r.value
+	}
+
+	return supplier(r.err)
+}
+
+// Optional returns a non-empty Optional containing the value if the Result is successful, or an empty Optional otherwise.
+// The error is discarded; use [Result.Get] or [Result.Err] to retrieve it.
+func (r Result[T]) Optional() Optional[T] {
+	if r.err != nil {
+		return Empty[T]()
+	}
+
+	return Of(r.value)
+}
+
+// String implements the [fmt.Stringer] interface.
+func (r Result[T]) String() string {
+	if r.err != nil {
+		return fmt.Sprintf("Result.error[%v]", r.err)
+	}
+
+	return fmt.Sprintf("Result[%v]", r.value)
+}
@@ -0,0 +1,453 @@
+package optional
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"testing"
+)
+
+func TestDefaultResultIsOk(t *testing.T) {
+	var result Result[string]
+
+	if !result.IsOk() {
+		t.Error("default Result should be ok")
+	}
+
+	if result.IsErr() {
+		t.Error("default Result should not be an error")
+	}
+}
+
+func TestOk(t *testing.T) {
+	result := Ok(1)
+
+	if !result.IsOk() || result.IsErr() {
+		t.Error("optional.Ok(1) should be ok")
+	}
+
+	value, err := result.Get()
+	if value != 1 || err != nil {
+		t.Errorf("optional.Ok(1).Get should return (1, nil), was (%v, %v)", value, err)
+	}
+
+	if result.Err() != nil {
+		t.Errorf("optional.Ok(1).Err should return nil, was %v", result.Err())
+	}
+}
+
+func TestErrWithNil(t *testing.T) {
+	result := Err[int](nil)
+
+	if result.IsOk() || !result.IsErr() {
+		t.Fatal("optional.Err(nil) should be an error")
+	}
+
+	var noValueErr *NoValueError
+	if !errors.As(result.Err(), &noValueErr) || noValueErr.Type != "int" || noValueErr.Cause != nil {
+		t.Errorf("optional.Err(nil) should contain a *NoValueError with type 'int' and no cause, was %v", result.Err())
+	}
+}
+
+func TestErr(t *testing.T) {
+	result := Err[int](io.EOF)
+
+	if result.IsOk() || !result.IsErr() {
+		t.Error("optional.Err(io.EOF) should be an error")
+	}
+
+	value, err := result.Get()
+	if value != 0 || !errors.Is(err, io.EOF) {
+		t.Errorf("optional.Err(io.EOF).Get should return (0, io.EOF), was (%v, %v)", value, err)
+	}
+
+	if !errors.Is(result.Err(), io.EOF) {
+		t.Errorf("optional.Err(io.EOF).Err should return io.EOF, was %v", result.Err())
+	}
+}
+
+func TestFromPair(t *testing.T) {
+	parameters := []struct {
+		name     string
+		input    string
+		expectOk bool
+		expected int
+	}{
+		{"valid", "1", true, 1},
+		{"invalid", "foo", false, 0},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			result := FromPair(strconv.Atoi(parameter.input))
+
+			if result.IsOk() != parameter.expectOk {
+				t.Errorf("FromPair(strconv.Atoi('%s')).IsOk should return %v", parameter.input, parameter.expectOk)
+			}
+
+			if value := result.OrElse(0); value != parameter.expected {
+				t.Errorf("FromPair(strconv.Atoi('%s')) should contain %v, was %v", parameter.input, parameter.expected, value)
+			}
+		})
+	}
+}
+
+func TestFromOptionalWhenEmpty(t *testing.T) {
+	supplier := capturingSupplier[error]{result: io.EOF}
+
+	result := FromOptional(Empty[int](), supplier.Invoke)
+
+	if !errors.Is(result.Err(), io.EOF) {
+		t.Errorf("FromOptional(optional.Empty()) should return a Result with error io.EOF, was %v", result)
+	}
+
+	if supplier.invocations != 1 {
+		t.Errorf("supplier given to FromOptional should be invoked once, #invocations: %v", supplier.invocations)
+	}
+}
+
+func TestFromOptionalWhenEmptyAndSupplierReturnsNil(t *testing.T) {
+	supplier := capturingSupplier[error]{result: nil}
+
+	result := FromOptional(Empty[int](), supplier.Invoke)
+
+	if !result.IsErr() || !errors.Is(result.Err(), ErrNoValuePresent) {
+		t.Fatalf("FromOptional should return a Result with an error matching optional.ErrNoValuePresent, was %v", result)
+	}
+
+	var noValueErr *NoValueError
+	if !errors.As(result.Err(), &noValueErr) || noValueErr.Type != "int" {
+		t.Errorf("FromOptional should return a Result with a *NoValueError with type 'int', was %v", result.Err())
+	}
+}
+
+func TestFromOptionalWhenPresent(t *testing.T) {
+	supplier := capturingSupplier[error]{result: io.EOF}
+
+	result := FromOptional(Of(1), supplier.Invoke)
+
+	if result != Ok(1) {
+		t.Errorf("FromOptional(optional.Of(1)) should return optional.Ok(1), was %v", result)
+	}
+
+	if supplier.invocations != 0 {
+		t.Errorf("supplier given to FromOptional should not be invoked, #invocations: %v", supplier.invocations)
+	}
+}
+
+func TestResultFilterWhenErr(t *testing.T) {
+	result := Err[int](io.EOF)
+
+	predicate := capturingPredicate[int]{result: false}
+	errorSupplier := capturingFunction[int, error]{result: io.ErrUnexpectedEOF}
+
+	filtered := result.Filter(predicate.Invoke, errorSupplier.Invoke)
+
+	if !errors.Is(filtered.Err(), io.EOF) {
+		t.Errorf("optional.Err(io.EOF).Filter should return a Result with error io.EOF, was %v", filtered)
+	}
+
+	if len(predicate.arguments) != 0 {
+		t.Errorf("predicate given to optional.Err(io.EOF).Filter should not be invoked, was invoked with %v", predicate.arguments)
+	}
+
+	if len(errorSupplier.arguments) != 0 {
+		t.Errorf("errorSupplier given to optional.Err(io.EOF).Filter should not be invoked, was invoked with %v", errorSupplier.arguments)
+	}
+}
+
+func TestResultFilterWhenOkAndPredicateReturnsTrue(t *testing.T) {
+	result := Ok(1)
+
+	predicate := capturingPredicate[int]{result: true}
+	errorSupplier := capturingFunction[int, error]{result: io.EOF}
+
+	filtered := result.Filter(predicate.Invoke, errorSupplier.Invoke)
+
+	if filtered != result {
+		t.Errorf("optional.Ok(1).Filter should return optional.Ok(1), was %v", filtered)
+	}
+
+	if len(predicate.arguments) != 1 || predicate.arguments[0] != 1 {
+		t.Errorf("predicate given to optional.Ok(1).Filter should be invoked with [1], was %v", predicate.arguments)
+	}
+
+	if len(errorSupplier.arguments) != 0 {
+		t.Errorf("errorSupplier given to optional.Ok(1).Filter should not be invoked, was invoked with %v", errorSupplier.arguments)
+	}
+}
+
+func TestResultFilterWhenOkAndPredicateReturnsFalse(t *testing.T) {
+	result := Ok(1)
+
+	predicate := capturingPredicate[int]{result: false}
+	errorSupplier := capturingFunction[int, error]{result: io.EOF}
+
+	filtered := result.Filter(predicate.Invoke, errorSupplier.Invoke)
+
+	if !errors.Is(filtered.Err(), io.EOF) {
+		t.Errorf("optional.Ok(1).Filter should return a Result with error io.EOF, was %v", filtered)
+	}
+
+	if len(predicate.arguments) != 1 || predicate.arguments[0] != 1 {
+		t.Errorf("predicate given to optional.Ok(1).Filter should be invoked with [1], was %v", predicate.arguments)
+	}
+
+	if len(errorSupplier.arguments) != 1 || errorSupplier.arguments[0] != 1 {
+		t.Errorf("errorSupplier given to optional.Ok(1).Filter should be invoked with [1], was %v", errorSupplier.arguments)
+	}
+}
+
+func TestResultFilterWhenOkAndPredicateReturnsFalseAndErrorSupplierReturnsNil(t *testing.T) {
+	result := Ok(3)
+
+	filtered := result.Filter(func(int) bool { return false }, func(int) error { return nil })
+
+	if filtered.IsOk() || !errors.Is(filtered.Err(), ErrNoValuePresent) {
+		t.Errorf("optional.Ok(3).Filter should return a Result with an error matching optional.ErrNoValuePresent, was %v", filtered)
+	}
+}
+
+func TestResultMapWhenErr(t *testing.T) {
+	result := Err[int](io.EOF)
+
+	mapper := capturingFunction[int, int]{result: 2}
+
+	mapped := result.Map(mapper.Invoke)
+
+	if !errors.Is(mapped.Err(), io.EOF) {
+		t.Errorf("optional.Err(io.EOF).Map should return a Result with error io.EOF, was %v", mapped)
+	}
+
+	if len(mapper.arguments) != 0 {
+		t.Errorf("mapper given to optional.Err(io.EOF).Map should not be invoked, was invoked with %v", mapper.arguments)
+	}
+}
+
+func TestResultMapWhenOk(t *testing.T) {
+	result := Ok(1)
+
+	mapper := capturingFunction[int, int]{result: 2}
+
+	mapped := result.Map(mapper.Invoke)
+
+	if mapped != Ok(2) {
+		t.Errorf("optional.Ok(1).Map should return optional.Ok(2), was %v", mapped)
+	}
+
+	if len(mapper.arguments) != 1 || mapper.arguments[0] != 1 {
+		t.Errorf("mapper given to optional.Ok(1).Map should be invoked with [1], was %v", mapper.arguments)
+	}
+}
+
+func TestMapResultWhenErr(t *testing.T) {
+	result := Err[int](io.EOF)
+
+	mapper := capturingFunction[int, string]{result: "foo"}
+
+	mapped := MapResult(result, mapper.Invoke)
+
+	if !errors.Is(mapped.Err(), io.EOF) {
+		t.Errorf("MapResult called with optional.Err(io.EOF) should return a Result with error io.EOF, was %v", mapped)
+	}
+
+	if len(mapper.arguments) != 0 {
+		t.Errorf("mapper given to MapResult should not be invoked, was invoked with %v", mapper.arguments)
+	}
+}
+
+func TestMapResultWhenOk(t *testing.T) {
+	result := Ok(1)
+
+	mapper := capturingFunction[int, string]{result: "foo"}
+
+	mapped := MapResult(result, mapper.Invoke)
+
+	if mapped != Ok("foo") {
+		t.Errorf("MapResult called with optional.Ok(1) should return optional.Ok('foo'), was %v", mapped)
+	}
+
+	if len(mapper.arguments) != 1 || mapper.arguments[0] != 1 {
+		t.Errorf("mapper given to MapResult should be invoked with [1], was %v", mapper.arguments)
+	}
+}
+
+func TestResultFlatMapWhenErr(t *testing.T) {
+	result := Err[int](io.EOF)
+
+	mapper := capturingFunction[int, Result[int]]{result: Ok(2)}
+
+	mapped := result.FlatMap(mapper.Invoke)
+
+	if !errors.Is(mapped.Err(), io.EOF) {
+		t.Errorf("optional.Err(io.EOF).FlatMap should return a Result with error io.EOF, was %v", mapped)
+	}
+
+	if len(mapper.arguments) != 0 {
+		t.Errorf("mapper given to optional.Err(io.EOF).FlatMap should not be invoked, was invoked with %v", mapper.arguments)
+	}
+}
+
+func TestResultFlatMapWhenOk(t *testing.T) {
+	parameters := []Result[int]{Ok(2), Err[int](io.EOF)}
+
+	for i := range parameters {
+		expected := parameters[i]
+
+		t.Run(expected.String(), func(t *testing.T) {
+			result := Ok(1)
+
+			mapper := capturingFunction[int, Result[int]]{result: expected}
+
+			mapped := result.FlatMap(mapper.Invoke)
+
+			if mapped != expected {
+				t.Errorf("optional.Ok(1).FlatMap should return %v, was %v", expected, mapped)
+			}
+
+			if len(mapper.arguments) != 1 || mapper.arguments[0] != 1 {
+				t.Errorf("mapper given to optional.Ok(1).FlatMap should be invoked with [1], was %v", mapper.arguments)
+			}
+		})
+	}
+}
+
+func TestFlatMapResultWhenErr(t *testing.T) {
+	result := Err[int](io.EOF)
+
+	mapper := capturingFunction[int, Result[string]]{result: Ok("foo")}
+
+	mapped := FlatMapResult(result, mapper.Invoke)
+
+	if !errors.Is(mapped.Err(), io.EOF) {
+		t.Errorf("FlatMapResult called with optional.Err(io.EOF) should return a Result with error io.EOF, was %v", mapped)
+	}
+
+	if len(mapper.arguments) != 0 {
+		t.Errorf("mapper given to FlatMapResult should not be invoked, was invoked with %v", mapper.arguments)
+	}
+}
+
+func TestFlatMapResultWhenOk(t *testing.T) {
+	parameters := []Result[string]{Ok("foo"), Err[string](io.EOF)}
+
+	for i := range parameters {
+		expected := parameters[i]
+
+		t.Run(expected.String(), func(t *testing.T) {
+			result := Ok(1)
+
+			mapper := capturingFunction[int, Result[string]]{result: expected}
+
+			mapped := FlatMapResult(result, mapper.Invoke)
+
+			if mapped != expected {
+				t.Errorf("FlatMapResult called with optional.Ok(1) should return %v, was %v", expected, mapped)
+			}
+
+			if len(mapper.arguments) != 1 || mapper.arguments[0] != 1 {
+				t.Errorf("mapper given to FlatMapResult should be invoked with [1], was %v", mapper.arguments)
+			}
+		})
+	}
+}
+
+func TestResultOrWhenErr(t *testing.T) {
+	result := Err[int](io.EOF)
+
+	supplier := capturingFunction[error, Result[int]]{result: Ok(2)}
+
+	result2 := result.Or(supplier.Invoke)
+
+	if result2 != Ok(2) {
+		t.Errorf("optional.Err(io.EOF).Or should return optional.Ok(2), was %v", result2)
+	}
+
+	if len(supplier.arguments) != 1 || !errors.Is(supplier.arguments[0], io.EOF) {
+		t.Errorf("supplier given to optional.Err(io.EOF).Or should be invoked with [io.EOF], was %v", supplier.arguments)
+	}
+}
+
+func TestResultOrWhenOk(t *testing.T) {
+	result := Ok(1)
+
+	supplier := capturingFunction[error, Result[int]]{result: Ok(2)}
+
+	result2 := result.Or(supplier.Invoke)
+
+	if result2 != result {
+		t.Errorf("optional.Ok(1).Or should return optional.Ok(1), was %v", result2)
+	}
+
+	if len(supplier.arguments) != 0 {
+		t.Errorf("supplier given to optional.Ok(1).Or should not be invoked, was invoked with %v", supplier.arguments)
+	}
+}
+
+func TestResultOrElse(t *testing.T) {
+	if value := Err[int](io.EOF).OrElse(2); value != 2 {
+		t.Errorf("optional.Err(io.EOF).OrElse(2) should return 2, was %v", value)
+	}
+
+	if value := Ok(1).OrElse(2); value != 1 {
+		t.Errorf("optional.Ok(1).OrElse(2) should return 1, was %v", value)
+	}
+}
+
+func TestResultOrElseGetWhenErr(t *testing.T) {
+	result := Err[int](io.EOF)
+
+	supplier := capturingFunction[error, int]{result: 2}
+
+	value := result.OrElseGet(supplier.Invoke)
+
+	if value != 2 {
+		t.Errorf("optional.Err(io.EOF).OrElseGet should return 2, was %v", value)
+	}
+
+	if len(supplier.arguments) != 1 || !errors.Is(supplier.arguments[0], io.EOF) {
+		t.Errorf("supplier given to optional.Err(io.EOF).OrElseGet should be invoked with [io.EOF], was %v", supplier.arguments)
+	}
+}
+
+func TestResultOrElseGetWhenOk(t *testing.T) {
+	result := Ok(1)
+
+	supplier := capturingFunction[error, int]{result: 2}
+
+	value := result.OrElseGet(supplier.Invoke)
+
+	if value != 1 {
+		t.Errorf("optional.Ok(1).OrElseGet should return 1, was %v", value)
+	}
+
+	if len(supplier.arguments) != 0 {
+		t.Errorf("supplier given to optional.Ok(1).OrElseGet should not be invoked, was invoked with %v", supplier.arguments)
+	}
+}
+
+func TestResultOptionalWhenErr(t *testing.T) {
+	if opt := Err[int](io.EOF).Optional(); opt != Empty[int]() {
+		t.Errorf("optional.Err(io.EOF).Optional should return optional.Empty(), was %v", opt)
+	}
+}
+
+func TestResultOptionalWhenOk(t *testing.T) {
+	opt := Ok(1).Optional()
+
+	if opt != Of(1) {
+		t.Errorf("optional.Ok(1).Optional should return optional.Of(1), was %v", opt)
+	}
+}
+
+func TestResultString(t *testing.T) {
+	if s := Ok(1).String(); s != "Result[1]" {
+		t.Errorf("optional.Ok(1).String should return 'Result[1]', was %v", s)
+	}
+
+	if s := Err[int](io.EOF).String(); s != "Result.error[EOF]" {
+		t.Errorf("optional.Err(io.EOF).String should return 'Result.error[EOF]', was %v", s)
+	}
+}
@@ -0,0 +1,34 @@
+package optional
+
+import (
+	"log/slog"
+)
+
+// LogValue implements the [slog.LogValuer] interface.
+// An empty Optional resolves to an empty value that is logged as null or <nil>, depending on the handler.
+// A non-empty Optional resolves to its value; if that implements [slog.LogValuer] itself, it will be resolved as well.
+//
+// To omit empty Optionals from logging, use [AttrOmitEmpty].
+func (o Optional[T]) LogValue() slog.Value {
+	if !o.present {
+		return slog.Value{}
+	}
+
+	return slog.AnyValue(o.value)
+}
+
+// Attr returns an [slog.Attr] for the given key and Optional.
+// If the Optional is empty, the Attr's value is empty.
+func Attr[T any](key string, optional Optional[T]) slog.Attr {
+	return slog.Any(key, optional)
+}
+
+// AttrOmitEmpty returns an [slog.Attr] for the given key and Optional if it's not empty, or an empty [slog.Attr] otherwise.
+// Empty Attrs are ignored by handlers.
+func AttrOmitEmpty[T any](key string, optional Optional[T]) slog.Attr {
+	if !optional.present {
+		return slog.Attr{}
+	}
+
+	return slog.Any(key, optional)
+}
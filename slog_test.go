@@ -0,0 +1,116 @@
+package optional
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+)
+
+type slogUser struct {
+	id   int
+	name string
+}
+
+func (u slogUser) LogValue() slog.Value {
+	return slog.GroupValue(slog.Int("id", u.id), slog.String("name", u.name))
+}
+
+func TestLogValueWhenEmpty(t *testing.T) {
+	value := Empty[int]().LogValue()
+
+	if value.Kind() != slog.KindAny || value.Any() != nil {
+		t.Errorf("optional.Empty().LogValue should return an empty value, was %v", value)
+	}
+}
+
+func TestLogValueWhenPresent(t *testing.T) {
+	value := Of(42).LogValue()
+
+	if value.Kind() != slog.KindInt64 || value.Int64() != 42 {
+		t.Errorf("optional.Of(42).LogValue should return an int64 value 42, was %v (%v)", value, value.Kind())
+	}
+}
+
+func TestLogValueResolve(t *testing.T) {
+	value := slog.AnyValue(Of(Of("foo"))).Resolve()
+
+	if value.Kind() != slog.KindString || value.String() != "foo" {
+		t.Errorf("resolved value should be string value 'foo', was %v (%v)", value, value.Kind())
+	}
+}
+
+func TestLogValueWithJSONHandler(t *testing.T) {
+	var buffer bytes.Buffer
+
+	logger := slog.New(slog.NewJSONHandler(&buffer, &slog.HandlerOptions{
+		ReplaceAttr: removeTime,
+	}))
+
+	logger.Info("test",
+		"empty", Empty[int](),
+		"int", Of(42),
+		"string", Of("foo"),
+		"user", Of(slogUser{id: 1, name: "John"}),
+		Attr("attr", Of(1.5)),
+		Attr("emptyAttr", Empty[string]()),
+		AttrOmitEmpty("omitted", Empty[string]()),
+		AttrOmitEmpty("notOmitted", Of(true)),
+	)
+
+	expected := `{"level":"INFO","msg":"test","empty":null,"int":42,"string":"foo","user":{"id":1,"name":"John"},` +
+		`"attr":1.5,"emptyAttr":null,"notOmitted":true}` + "\n"
+	if buffer.String() != expected {
+		t.Errorf("logged JSON should be '%s', was '%s'", expected, buffer.String())
+	}
+}
+
+func TestLogValueWithTextHandler(t *testing.T) {
+	var buffer bytes.Buffer
+
+	logger := slog.New(slog.NewTextHandler(&buffer, &slog.HandlerOptions{
+		ReplaceAttr: removeTime,
+	}))
+
+	logger.Info("test", "int", Of(42), AttrOmitEmpty("omitted", Empty[int]()))
+
+	expected := "level=INFO msg=test int=42\n"
+	if buffer.String() != expected {
+		t.Errorf("logged text should be '%s', was '%s'", expected, buffer.String())
+	}
+}
+
+func TestAttr(t *testing.T) {
+	attr := Attr("key", Of(1))
+
+	if attr.Key != "key" {
+		t.Errorf("key should be 'key', was '%s'", attr.Key)
+	}
+
+	if value := attr.Value.Resolve(); value.Kind() != slog.KindInt64 || value.Int64() != 1 {
+		t.Errorf("resolved value should be int64 value 1, was %v", value)
+	}
+}
+
+func TestAttrOmitEmpty(t *testing.T) {
+	if attr := AttrOmitEmpty("key", Empty[int]()); !attr.Equal(slog.Attr{}) {
+		t.Errorf("AttrOmitEmpty with optional.Empty() should return an empty Attr, was %v", attr)
+	}
+
+	attr := AttrOmitEmpty("key", Of(1))
+
+	if attr.Key != "key" {
+		t.Errorf("key should be 'key', was '%s'", attr.Key)
+	}
+
+	if value := attr.Value.Resolve(); value.Kind() != slog.KindInt64 || value.Int64() != 1 {
+		t.Errorf("resolved value should be int64 value 1, was %v", value)
+	}
+}
+
+func removeTime(groups []string, a slog.Attr) slog.Attr {
+	if a.Key == slog.TimeKey && len(groups) == 0 {
+		return slog.Attr{}
+	}
+
+	return a
+}
@@ -0,0 +1,64 @@
+package optional
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+)
+
+// OfNull returns a non-empty Optional describing the value of the given [sql.Null] if it's valid, or an empty Optional otherwise.
+func OfNull[T any](value sql.Null[T]) Optional[T] {
+	if !value.Valid {
+		return Empty[T]()
+	}
+
+	return Of(value.V)
+}
+
+// Null returns a valid [sql.Null] containing the value if present, or an invalid [sql.Null] otherwise.
+func (o Optional[T]) Null() sql.Null[T] {
+	if !o.present {
+		return sql.Null[T]{}
+	}
+
+	return sql.Null[T]{V: o.value, Valid: true}
+}
+
+// Scan implements the [sql.Scanner] interface.
+// A NULL value results in an empty Optional, any other value is converted using the same rules as [sql.Null].
+func (o *Optional[T]) Scan(value any) error {
+	var null sql.Null[T]
+	if err := null.Scan(value); err != nil {
+		return err
+	}
+
+	*o = OfNull(null)
+
+	return nil
+}
+
+// Value implements the [driver.Valuer] interface.
+// An empty Optional results in NULL, a non-empty Optional is converted using the same rules as [sql.Null]:
+// if the value implements [driver.Valuer] its Value method is called first,
+// and the result is converted using [driver.DefaultParameterConverter].
+func (o Optional[T]) Value() (driver.Value, error) {
+	if !o.present {
+		return nil, nil
+	}
+
+	value := any(o.value)
+
+	if valuer, ok := value.(driver.Valuer); ok {
+		// like database/sql, treat nil pointers to types that implement driver.Valuer using a value receiver as NULL
+		if v := reflect.ValueOf(valuer); v.Kind() == reflect.Pointer && v.IsNil() && v.Type().Elem().Implements(reflect.TypeFor[driver.Valuer]()) {
+			return nil, nil
+		}
+
+		var err error
+		if value, err = valuer.Value(); err != nil {
+			return nil, err
+		}
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(value)
+}
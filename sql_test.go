@@ -0,0 +1,193 @@
+package optional
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+type sqlValuer struct {
+	value driver.Value
+	err   error
+}
+
+func (v sqlValuer) Value() (driver.Value, error) {
+	return v.value, v.err
+}
+
+func TestOfNullWhenInvalid(t *testing.T) {
+	opt := OfNull(sql.Null[int]{V: 1, Valid: false})
+
+	if !opt.IsEmpty() {
+		t.Errorf("optional.OfNull with an invalid sql.Null should return an empty Optional, was %v", opt)
+	}
+}
+
+func TestOfNullWhenValid(t *testing.T) {
+	opt := OfNull(sql.Null[int]{V: 1, Valid: true})
+
+	if !Equal(opt, Of(1)) {
+		t.Errorf("optional.OfNull with a valid sql.Null should return optional.Of(1), was %v", opt)
+	}
+}
+
+func TestNullWhenEmpty(t *testing.T) {
+	opt := Empty[int]()
+
+	null := opt.Null()
+
+	if null.Valid || null.V != 0 {
+		t.Errorf("optional.Empty().Null should return an invalid sql.Null, was %v", null)
+	}
+}
+
+func TestNullWhenPresent(t *testing.T) {
+	opt := Of(1)
+
+	null := opt.Null()
+
+	if !null.Valid || null.V != 1 {
+		t.Errorf("optional.Of(1).Null should return a valid sql.Null with value 1, was %v", null)
+	}
+}
+
+func TestScanWithNil(t *testing.T) {
+	opt := Of("foo")
+
+	if err := opt.Scan(nil); err != nil {
+		t.Fatalf("Scan(nil) should not return an error, was %v", err)
+	}
+
+	if !opt.IsEmpty() {
+		t.Errorf("Scan(nil) should result in an empty Optional, was %v", opt)
+	}
+}
+
+func TestScanWithValue(t *testing.T) {
+	now := time.Now()
+
+	t.Run("string", func(t *testing.T) {
+		var opt Optional[string]
+
+		if err := opt.Scan([]byte("foo")); err != nil {
+			t.Fatalf("Scan should not return an error, was %v", err)
+		}
+
+		if !Equal(opt, Of("foo")) {
+			t.Errorf("Scan should result in optional.Of('foo'), was %v", opt)
+		}
+	})
+
+	t.Run("int", func(t *testing.T) {
+		var opt Optional[int]
+
+		if err := opt.Scan(int64(1)); err != nil {
+			t.Fatalf("Scan should not return an error, was %v", err)
+		}
+
+		if !Equal(opt, Of(1)) {
+			t.Errorf("Scan should result in optional.Of(1), was %v", opt)
+		}
+	})
+
+	t.Run("time", func(t *testing.T) {
+		var opt Optional[time.Time]
+
+		if err := opt.Scan(now); err != nil {
+			t.Fatalf("Scan should not return an error, was %v", err)
+		}
+
+		if !Equal(opt, Of(now)) {
+			t.Errorf("Scan should result in optional.Of(%v), was %v", now, opt)
+		}
+	})
+}
+
+func TestScanWithInvalidValue(t *testing.T) {
+	opt := Of(1)
+
+	if err := opt.Scan("foo"); err == nil {
+		t.Errorf("Scan('foo') into an Optional[int] should return an error")
+	}
+
+	if !Equal(opt, Of(1)) {
+		t.Errorf("Scan with an invalid value should not modify the Optional, was %v", opt)
+	}
+}
+
+func TestValueWhenEmpty(t *testing.T) {
+	opt := Empty[string]()
+
+	value, err := opt.Value()
+	if err != nil {
+		t.Fatalf("optional.Empty().Value should not return an error, was %v", err)
+	}
+
+	if value != nil {
+		t.Errorf("optional.Empty().Value should return nil, was %v", value)
+	}
+}
+
+func TestValueWhenPresent(t *testing.T) {
+	parameters := []struct {
+		name     string
+		valuer   driver.Valuer
+		expected driver.Value
+	}{
+		{"string", Of("foo"), "foo"},
+		{"int", Of(1), int64(1)},
+		{"float32", Of(float32(0.5)), float64(0.5)},
+		{"bool", Of(true), true},
+		{"nested", Of(Of(int8(1))), int64(1)},
+		{"valuer", Of(sqlValuer{value: int32(1)}), int64(1)},
+		{"pointer to valuer", Of(&sqlValuer{value: "foo"}), "foo"},
+		{"nil pointer to valuer", Of[*sqlValuer](nil), nil},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			value, err := parameter.valuer.Value()
+			if err != nil {
+				t.Fatalf("Value should not return an error, was %v", err)
+			}
+
+			if value != parameter.expected {
+				t.Errorf("Value should return %v (%T), was %v (%T)", parameter.expected, parameter.expected, value, value)
+			}
+		})
+	}
+}
+
+func TestValueWithUnsupportedType(t *testing.T) {
+	parameters := []struct {
+		name   string
+		valuer driver.Valuer
+	}{
+		{"struct", Of(struct{}{})},
+		{"slice", Of([]int{1})},
+		{"valuer returning unsupported type", Of(sqlValuer{value: struct{}{}})},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			if _, err := parameter.valuer.Value(); err == nil {
+				t.Errorf("Value with an unsupported type should return an error")
+			}
+		})
+	}
+}
+
+func TestValueWithValuerReturningError(t *testing.T) {
+	opt := Of(sqlValuer{err: io.EOF})
+
+	if _, err := opt.Value(); !errors.Is(err, io.EOF) {
+		t.Errorf("Value should return the error of the value's Value method, was %v", err)
+	}
+}
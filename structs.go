@@ -0,0 +1,52 @@
+package optional
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// walkStructs calls the given visitor for each exported field of src, together with the struct dst points to.
+// The dst must be a non-nil pointer to a struct; the src must be a struct or a non-nil pointer to a struct.
+// If not, the given error is returned.
+func walkStructs(dst any, src any, errInvalidArguments error, visitor func(dst reflect.Value, field reflect.StructField, src reflect.Value) error) error {
+	dstValue := reflect.ValueOf(dst)
+	if dstValue.Kind() != reflect.Pointer || dstValue.IsNil() || dstValue.Elem().Kind() != reflect.Struct {
+		return errInvalidArguments
+	}
+
+	dstValue = dstValue.Elem()
+
+	srcValue := reflect.ValueOf(src)
+	if srcValue.Kind() == reflect.Pointer && !srcValue.IsNil() {
+		srcValue = srcValue.Elem()
+	}
+
+	if srcValue.Kind() != reflect.Struct {
+		return errInvalidArguments
+	}
+
+	srcType := srcValue.Type()
+	for i := range srcType.NumField() {
+		field := srcType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		if err := visitor(dstValue, field, srcValue.Field(i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// settableField returns the settable field with the given name of the given struct, or an error if there is no such field.
+// The given role is used to describe the struct in the error.
+func settableField(structValue reflect.Value, name string, role string) (reflect.Value, error) {
+	field := structValue.FieldByName(name)
+	if !field.IsValid() || !field.CanSet() {
+		return field, fmt.Errorf("%s of type %s has no settable field %s", role, structValue.Type(), name)
+	}
+
+	return field, nil
+}
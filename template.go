@@ -0,0 +1,70 @@
+package optional
+
+import (
+	"text/template"
+)
+
+// FuncMap returns a function map for use with the text/template and html/template packages.
+// It contains the following functions:
+//   - present returns true if its argument is a non-empty Optional, or false otherwise.
+//     Use {{ if present .Field }} instead of {{ if .Field }}, as the latter is always true for Optionals.
+//   - empty returns true if its argument is an empty Optional, or false otherwise.
+//   - optValue returns the value of its argument if it's a non-empty Optional, or fails with [ErrNoValuePresent] otherwise.
+//   - orElse returns the value of its second argument if it's a non-empty Optional, or its first argument otherwise.
+//     This allows it to be used in pipelines: {{ .Field | orElse "n/a" }}.
+//   - withOpt returns the value of its argument if it's a non-empty Optional, or nil otherwise.
+//     This allows it to be used with the with action: {{ with withOpt .Field }}{{ . }}{{ end }}.
+//     Note that the with action also skips present values that are empty according to the template package, like 0 or "".
+//
+// Arguments that are not Optionals are treated as non-empty Optionals containing the argument if it's not nil,
+// or as empty Optionals otherwise.
+//
+// Each call returns a new map, which can be modified freely.
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"present":  templatePresent,
+		"empty":    templateEmpty,
+		"optValue": templateValue,
+		"orElse":   templateOrElse,
+		"withOpt":  templateWith,
+	}
+}
+
+func templatePresent(value any) bool {
+	_, ok := unwrapAny(value)
+
+	return ok
+}
+
+func templateEmpty(value any) bool {
+	_, ok := unwrapAny(value)
+
+	return !ok
+}
+
+func templateValue(value any) (any, error) {
+	value, ok := unwrapAny(value)
+	if !ok {
+		return nil, ErrNoValuePresent
+	}
+
+	return value, nil
+}
+
+func templateOrElse(other any, value any) any {
+	value, ok := unwrapAny(value)
+	if !ok {
+		return other
+	}
+
+	return value
+}
+
+func templateWith(value any) any {
+	value, ok := unwrapAny(value)
+	if !ok {
+		return nil
+	}
+
+	return value
+}
@@ -0,0 +1,108 @@
+package optional
+
+import (
+	"errors"
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+)
+
+type templateData struct {
+	Name     Optional[string]
+	Nickname Optional[string]
+	Age      Optional[int]
+	Tags     Optional[[]string]
+}
+
+func TestFuncMap(t *testing.T) {
+	data := templateData{
+		Name: Of("John"),
+		Age:  Of(42),
+		Tags: Of([]string{"a", "b"}),
+	}
+
+	parameters := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{"present when present", `{{ if present .Name }}yes{{ else }}no{{ end }}`, "yes"},
+		{"present when empty", `{{ if present .Nickname }}yes{{ else }}no{{ end }}`, "no"},
+		{"present with non-Optional", `{{ if present 1 }}yes{{ else }}no{{ end }}`, "yes"},
+		{"present with nil", `{{ if present nil }}yes{{ else }}no{{ end }}`, "no"},
+		{"empty when present", `{{ if empty .Name }}yes{{ else }}no{{ end }}`, "no"},
+		{"empty when empty", `{{ if empty .Nickname }}yes{{ else }}no{{ end }}`, "yes"},
+		{"optValue when present", `{{ optValue .Age }}`, "42"},
+		{"orElse when present", `{{ orElse "n/a" .Name }}`, "John"},
+		{"orElse when empty", `{{ orElse "n/a" .Nickname }}`, "n/a"},
+		{"orElse in pipeline", `{{ .Nickname | orElse "n/a" }}`, "n/a"},
+		{"withOpt when present", `{{ with withOpt .Name }}Hello {{ . }}{{ else }}Hello stranger{{ end }}`, "Hello John"},
+		{"withOpt when empty", `{{ with withOpt .Nickname }}Hello {{ . }}{{ else }}Hello stranger{{ end }}`, "Hello stranger"},
+		{"withOpt with range", `{{ range withOpt .Tags }}[{{ . }}]{{ end }}`, "[a][b]"},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			tmpl := template.Must(template.New("test").Funcs(FuncMap()).Parse(parameter.template))
+
+			var builder strings.Builder
+			if err := tmpl.Execute(&builder, data); err != nil {
+				t.Fatalf("Execute should not return an error, was %v", err)
+			}
+
+			if builder.String() != parameter.expected {
+				t.Errorf("template should render '%s', was '%s'", parameter.expected, builder.String())
+			}
+		})
+	}
+}
+
+func TestFuncMapOptValueWhenEmpty(t *testing.T) {
+	tmpl := template.Must(template.New("test").Funcs(FuncMap()).Parse(`{{ optValue .Nickname }}`))
+
+	var builder strings.Builder
+
+	err := tmpl.Execute(&builder, templateData{})
+	if !errors.Is(err, ErrNoValuePresent) {
+		t.Errorf("Execute should return an error matching ErrNoValuePresent, was %v", err)
+	}
+}
+
+func TestFuncMapWithHTMLTemplate(t *testing.T) {
+	tmpl := htmltemplate.Must(htmltemplate.New("test").Funcs(FuncMap()).Parse(`<p>{{ .Name | orElse "<none>" }}</p>`))
+
+	parameters := []struct {
+		data     templateData
+		expected string
+	}{
+		{templateData{Name: Of("<b>John</b>")}, "<p>&lt;b&gt;John&lt;/b&gt;</p>"},
+		{templateData{}, "<p>&lt;none&gt;</p>"},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.expected, func(t *testing.T) {
+			var builder strings.Builder
+			if err := tmpl.Execute(&builder, parameter.data); err != nil {
+				t.Fatalf("Execute should not return an error, was %v", err)
+			}
+
+			if builder.String() != parameter.expected {
+				t.Errorf("template should render '%s', was '%s'", parameter.expected, builder.String())
+			}
+		})
+	}
+}
+
+func TestFuncMapReturnsNewMap(t *testing.T) {
+	funcMap := FuncMap()
+	delete(funcMap, "present")
+
+	if _, ok := FuncMap()["present"]; !ok {
+		t.Error("FuncMap should return a new map")
+	}
+}
@@ -0,0 +1,160 @@
+package optional
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// An empty Optional is marshalled as empty text.
+//
+// A non-empty Optional is marshalled by delegating to the value if it implements [encoding.TextMarshaler].
+// Otherwise, values of type [time.Duration] are marshalled using [time.Duration.String],
+// and values of string, bool, integer and floating point kinds are marshalled using the [strconv] package.
+// Values of any other type result in an error.
+func (o Optional[T]) MarshalText() ([]byte, error) {
+	if !o.present {
+		return []byte{}, nil
+	}
+
+	return marshalText(o.value)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// Empty text is unmarshalled as an empty Optional; use [KeepEmpty] to unmarshal it as an empty string instead.
+//
+// Other text is unmarshalled by delegating to a pointer to the value if it implements [encoding.TextUnmarshaler].
+// Otherwise, values of type [time.Duration] are unmarshalled using [time.ParseDuration],
+// and values of string, bool, integer and floating point kinds are unmarshalled using the [strconv] package.
+// Values of any other type result in an error.
+//
+// Optionals can be used as map keys with the encoding/json package.
+// Unmarshalling them as map keys requires Go 1.24 or later; earlier versions call [Optional.UnmarshalJSON] with the quoted key instead.
+func (o *Optional[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*o = Empty[T]()
+
+		return nil
+	}
+
+	return o.unmarshalText(text)
+}
+
+// KeepEmpty is an Optional of a string type that unmarshals empty text as an empty string instead of an empty Optional.
+// Its UnmarshalText method is used by all packages that rely on [encoding.TextUnmarshaler], like flag and encoding/json:
+//
+//	var name optional.KeepEmpty[string]
+//	flag.TextVar(&name, "name", optional.KeepEmpty[string]{}, "the name, possibly empty")
+//
+// All other methods are those of the embedded Optional.
+type KeepEmpty[T ~string] struct {
+	Optional[T]
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// Unlike [Optional.UnmarshalText], empty text is unmarshalled as a non-empty Optional containing an empty string.
+func (k *KeepEmpty[T]) UnmarshalText(text []byte) error {
+	return k.unmarshalText(text)
+}
+
+func (o *Optional[T]) unmarshalText(text []byte) error {
+	value, err := unmarshalText[T](text)
+	if err != nil {
+		return err
+	}
+
+	*o = Of(value)
+
+	return nil
+}
+
+func marshalText[T any](value T) ([]byte, error) {
+	if marshaler, ok := any(value).(encoding.TextMarshaler); ok {
+		return marshaler.MarshalText()
+	}
+
+	if marshaler, ok := any(&value).(encoding.TextMarshaler); ok {
+		return marshaler.MarshalText()
+	}
+
+	if duration, ok := any(value).(time.Duration); ok {
+		return []byte(duration.String()), nil
+	}
+
+	v := reflect.ValueOf(&value).Elem()
+
+	switch v.Kind() {
+	case reflect.String:
+		return []byte(v.String()), nil
+	case reflect.Bool:
+		return strconv.AppendBool(nil, v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(nil, v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(nil, v.Float(), 'g', -1, v.Type().Bits()), nil
+	default:
+		return nil, fmt.Errorf("cannot marshal value of type %s as text", v.Type())
+	}
+}
+
+func unmarshalText[T any](text []byte) (T, error) {
+	var value T
+
+	if unmarshaler, ok := any(&value).(encoding.TextUnmarshaler); ok {
+		err := unmarshaler.UnmarshalText(text)
+
+		return value, err
+	}
+
+	if duration, ok := any(&value).(*time.Duration); ok {
+		var err error
+		*duration, err = time.ParseDuration(string(text))
+
+		return value, err
+	}
+
+	v := reflect.ValueOf(&value).Elem()
+	s := string(text)
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return value, err
+		}
+
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return value, err
+		}
+
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return value, err
+		}
+
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return value, err
+		}
+
+		v.SetFloat(f)
+	default:
+		return value, fmt.Errorf("cannot unmarshal text into value of type %s", v.Type())
+	}
+
+	return value, nil
+}
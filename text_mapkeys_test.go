@@ -0,0 +1,36 @@
+//go:build go1.24
+
+package optional
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// Before Go 1.24, the encoding/json package calls UnmarshalJSON instead of UnmarshalText for map keys.
+
+func TestTextWithJSONMapKeys(t *testing.T) {
+	original := map[Optional[int]]string{
+		Of(1):        "one",
+		Empty[int](): "none",
+	}
+
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("json.Marshal should not return an error, was %v", err)
+	}
+
+	expected := `{"":"none","1":"one"}`
+	if string(data) != expected {
+		t.Errorf("json.Marshal should return '%s', was '%s'", expected, data)
+	}
+
+	var result map[Optional[int]]string
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatalf("json.Unmarshal should not return an error, was %v", err)
+	}
+
+	if len(result) != 2 || result[Of(1)] != "one" || result[Empty[int]()] != "none" {
+		t.Errorf("json.Unmarshal should return %v, was %v", original, result)
+	}
+}
@@ -0,0 +1,286 @@
+package optional
+
+import (
+	"encoding"
+	"flag"
+	"io"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+type textLevel int
+
+type textColor struct {
+	name string
+}
+
+func (c textColor) MarshalText() ([]byte, error) {
+	return []byte("color:" + c.name), nil
+}
+
+func (c *textColor) UnmarshalText(text []byte) error {
+	c.name = string(text)
+
+	return nil
+}
+
+func TestMarshalTextWhenEmpty(t *testing.T) {
+	text, err := Empty[int]().MarshalText()
+	if err != nil {
+		t.Fatalf("optional.Empty().MarshalText should not return an error, was %v", err)
+	}
+
+	if len(text) != 0 {
+		t.Errorf("optional.Empty().MarshalText should return empty text, was '%s'", text)
+	}
+}
+
+func TestMarshalTextWhenPresent(t *testing.T) {
+	parameters := []struct {
+		name      string
+		marshaler encoding.TextMarshaler
+		expected  string
+	}{
+		{"string", Of("foo"), "foo"},
+		{"empty string", Of(""), ""},
+		{"bool", Of(true), "true"},
+		{"int", Of(-1), "-1"},
+		{"int8", Of(int8(-128)), "-128"},
+		{"named int", Of(textLevel(3)), "3"},
+		{"uint64", Of(uint64(18446744073709551615)), "18446744073709551615"},
+		{"float32", Of(float32(0.1)), "0.1"},
+		{"float64", Of(1.5e100), "1.5e+100"},
+		{"duration", Of(90 * time.Second), "1m30s"},
+		{"text marshaler", Of(netip.MustParseAddr("127.0.0.1")), "127.0.0.1"},
+		{"custom text marshaler", Of(textColor{name: "red"}), "color:red"},
+		{"nested", Of(Of(1)), "1"},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			text, err := parameter.marshaler.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText should not return an error, was %v", err)
+			}
+
+			if string(text) != parameter.expected {
+				t.Errorf("MarshalText should return '%s', was '%s'", parameter.expected, text)
+			}
+		})
+	}
+}
+
+func TestMarshalTextWithUnsupportedType(t *testing.T) {
+	if _, err := Of([]int{1}).MarshalText(); err == nil {
+		t.Errorf("MarshalText with an unsupported type should return an error")
+	}
+}
+
+func TestUnmarshalTextWithEmptyText(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		opt := Of(1)
+
+		if err := opt.UnmarshalText([]byte{}); err != nil {
+			t.Fatalf("UnmarshalText should not return an error, was %v", err)
+		}
+
+		if !opt.IsEmpty() {
+			t.Errorf("UnmarshalText with empty text should result in an empty Optional, was %v", opt)
+		}
+	})
+
+	t.Run("string", func(t *testing.T) {
+		opt := Of("foo")
+
+		if err := opt.UnmarshalText([]byte{}); err != nil {
+			t.Fatalf("UnmarshalText should not return an error, was %v", err)
+		}
+
+		if !opt.IsEmpty() {
+			t.Errorf("UnmarshalText with empty text should result in an empty Optional, was %v", opt)
+		}
+	})
+}
+
+func TestKeepEmptyUnmarshalText(t *testing.T) {
+	type name string
+
+	t.Run("empty string", func(t *testing.T) {
+		var opt KeepEmpty[string]
+
+		if err := opt.UnmarshalText([]byte{}); err != nil {
+			t.Fatalf("UnmarshalText should not return an error, was %v", err)
+		}
+
+		if opt.Optional != Of("") {
+			t.Errorf("UnmarshalText with empty text should result in optional.Of(''), was %v", opt)
+		}
+	})
+
+	t.Run("empty named string", func(t *testing.T) {
+		var opt KeepEmpty[name]
+
+		if err := opt.UnmarshalText([]byte{}); err != nil {
+			t.Fatalf("UnmarshalText should not return an error, was %v", err)
+		}
+
+		if opt.Optional != Of(name("")) {
+			t.Errorf("UnmarshalText with empty text should result in optional.Of(''), was %v", opt)
+		}
+	})
+
+	t.Run("non-empty string", func(t *testing.T) {
+		var opt KeepEmpty[string]
+
+		if err := opt.UnmarshalText([]byte("foo")); err != nil {
+			t.Fatalf("UnmarshalText should not return an error, was %v", err)
+		}
+
+		if opt.Optional != Of("foo") {
+			t.Errorf("UnmarshalText should result in optional.Of('foo'), was %v", opt)
+		}
+	})
+}
+
+func TestKeepEmptyWithFlagTextVar(t *testing.T) {
+	parameters := []struct {
+		name     string
+		args     []string
+		expected Optional[string]
+	}{
+		{"not set", []string{}, Empty[string]()},
+		{"set", []string{"-name=foo"}, Of("foo")},
+		{"set to empty", []string{"-name="}, Of("")},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			var name KeepEmpty[string]
+
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.TextVar(&name, "name", KeepEmpty[string]{}, "name")
+
+			if err := fs.Parse(parameter.args); err != nil {
+				t.Fatalf("Parse should not return an error, was %v", err)
+			}
+
+			if name.Optional != parameter.expected {
+				t.Errorf("name should be %v, was %v", parameter.expected, name.Optional)
+			}
+		})
+	}
+}
+
+func TestUnmarshalText(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		testUnmarshalText(t, "foo", Of("foo"))
+	})
+	t.Run("bool", func(t *testing.T) {
+		testUnmarshalText(t, "true", Of(true))
+	})
+	t.Run("int", func(t *testing.T) {
+		testUnmarshalText(t, "-1", Of(-1))
+	})
+	t.Run("int8", func(t *testing.T) {
+		testUnmarshalText(t, "-128", Of(int8(-128)))
+	})
+	t.Run("named int", func(t *testing.T) {
+		testUnmarshalText(t, "3", Of(textLevel(3)))
+	})
+	t.Run("uint64", func(t *testing.T) {
+		testUnmarshalText(t, "18446744073709551615", Of(uint64(18446744073709551615)))
+	})
+	t.Run("float32", func(t *testing.T) {
+		testUnmarshalText(t, "0.1", Of(float32(0.1)))
+	})
+	t.Run("duration", func(t *testing.T) {
+		testUnmarshalText(t, "1m30s", Of(90*time.Second))
+	})
+	t.Run("text unmarshaler", func(t *testing.T) {
+		testUnmarshalText(t, "127.0.0.1", Of(netip.MustParseAddr("127.0.0.1")))
+	})
+	t.Run("custom text unmarshaler", func(t *testing.T) {
+		testUnmarshalText(t, "red", Of(textColor{name: "red"}))
+	})
+	t.Run("nested", func(t *testing.T) {
+		testUnmarshalText(t, "1", Of(Of(1)))
+	})
+}
+
+func testUnmarshalText[T comparable](t *testing.T, text string, expected Optional[T]) {
+	t.Helper()
+
+	var opt Optional[T]
+
+	if err := opt.UnmarshalText([]byte(text)); err != nil {
+		t.Fatalf("UnmarshalText should not return an error, was %v", err)
+	}
+
+	if opt != expected {
+		t.Errorf("UnmarshalText should result in %v, was %v", expected, opt)
+	}
+}
+
+func TestUnmarshalTextWithInvalidText(t *testing.T) {
+	parameters := []struct {
+		name        string
+		unmarshaler encoding.TextUnmarshaler
+		text        string
+	}{
+		{"bool", &Optional[bool]{}, "foo"},
+		{"int", &Optional[int]{}, "foo"},
+		{"int8 overflow", &Optional[int8]{}, "128"},
+		{"uint", &Optional[uint]{}, "-1"},
+		{"float64", &Optional[float64]{}, "foo"},
+		{"duration", &Optional[time.Duration]{}, "foo"},
+		{"text unmarshaler", &Optional[netip.Addr]{}, "foo"},
+		{"unsupported type", &Optional[[]int]{}, "1"},
+		{"interface", &Optional[io.Reader]{}, "foo"},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			if err := parameter.unmarshaler.UnmarshalText([]byte(parameter.text)); err == nil {
+				t.Errorf("UnmarshalText('%s') should return an error", parameter.text)
+			}
+		})
+	}
+}
+
+func TestTextWithFlagTextVar(t *testing.T) {
+	parameters := []struct {
+		name     string
+		args     []string
+		expected Optional[time.Duration]
+	}{
+		{"not set", []string{}, Empty[time.Duration]()},
+		{"set", []string{"-timeout=5s"}, Of(5 * time.Second)},
+		{"set to zero", []string{"-timeout=0s"}, Of(time.Duration(0))},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			var timeout Optional[time.Duration]
+
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.TextVar(&timeout, "timeout", Empty[time.Duration](), "timeout")
+
+			if err := fs.Parse(parameter.args); err != nil {
+				t.Fatalf("Parse should not return an error, was %v", err)
+			}
+
+			if timeout != parameter.expected {
+				t.Errorf("timeout should be %v, was %v", parameter.expected, timeout)
+			}
+		})
+	}
+}
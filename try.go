@@ -0,0 +1,102 @@
+package optional
+
+// StepError is the error of failed Results returned by [TryMapStep] and [TryFlatMapStep] if their mapper function fails.
+// It records the name of the step that failed.
+type StepError struct {
+	// Step is the name of the step that failed.
+	Step string
+	// Err is the error returned by the step's mapper function,
+	// or a [*NoValueError] if the mapper function of [TryFlatMapStep] returned an empty Optional.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *StepError) Error() string {
+	return e.Step + ": " + e.Err.Error()
+}
+
+// Unwrap returns the error of the step.
+func (e *StepError) Unwrap() error {
+	return e.Err
+}
+
+// TryMap returns a non-empty Optional containing the result of calling the given mapper function on the given Optional's value if present,
+// or an empty Optional otherwise.
+// If the mapper function returns an error, TryMap returns an empty Optional and the error.
+func TryMap[T any, U any](optional Optional[T], mapper func(value T) (U, error)) (Optional[U], error) {
+	if !optional.present {
+		return Empty[U](), nil
+	}
+
+	value, err := mapper(optional.value)
+	if err != nil {
+		return Empty[U](), err
+	}
+
+	return Of(value), nil
+}
+
+// TryFlatMap returns the result of applying the given function if the given Optional's value is present, or an empty Optional otherwise.
+// If the mapper function returns an error, TryFlatMap returns an empty Optional and the error.
+func TryFlatMap[T any, U any](optional Optional[T], mapper func(value T) (Optional[U], error)) (Optional[U], error) {
+	if !optional.present {
+		return Empty[U](), nil
+	}
+
+	result, err := mapper(optional.value)
+	if err != nil {
+		return Empty[U](), err
+	}
+
+	return result, nil
+}
+
+// TryMapStep returns a successful Result containing the result of calling the given mapper function on the given Result's value if successful,
+// or a failed Result with the same error otherwise.
+// If the mapper function returns an error, TryMapStep returns a failed Result with a [*StepError] containing the given step name and the error.
+//
+// Because failed Results propagate their errors, calls to TryMapStep can be chained; the first failing step short-circuits the chain,
+// and can be retrieved from the Result's error using [errors.As]. Use [Optional.Because] or [FromOptional] to start a chain from an Optional:
+//
+//	port := optional.TryMapStep(optional.TryMapStep(opt.Because(errMissing), "trim", trim), "parse", strconv.Atoi)
+//	if err := port.Err(); err != nil {
+//		var stepErr *optional.StepError
+//		if errors.As(err, &stepErr) {
+//			// stepErr.Step is the name of the failing step
+//		}
+//	}
+func TryMapStep[T any, U any](result Result[T], step string, mapper func(value T) (U, error)) Result[U] {
+	if result.err != nil {
+		return Err[U](result.err)
+	}
+
+	value, err := mapper(result.value)
+	if err != nil {
+		return Err[U](&StepError{Step: step, Err: err})
+	}
+
+	return Ok(value)
+}
+
+// TryFlatMapStep returns a successful Result containing the value of the Optional returned by the given mapper function if successful and present,
+// or a failed Result with the same error if the given Result is failed.
+// If the mapper function returns an error, TryFlatMapStep returns a failed Result with a [*StepError] containing the given step name and the error.
+// If the mapper function returns an empty Optional, the [*StepError] contains a [*NoValueError] instead.
+//
+// Like [TryMapStep], calls to TryFlatMapStep can be chained; the first failing step short-circuits the chain.
+func TryFlatMapStep[T any, U any](result Result[T], step string, mapper func(value T) (Optional[U], error)) Result[U] {
+	if result.err != nil {
+		return Err[U](result.err)
+	}
+
+	optional, err := mapper(result.value)
+	if err != nil {
+		return Err[U](&StepError{Step: step, Err: err})
+	}
+
+	if !optional.present {
+		return Err[U](&StepError{Step: step, Err: newNoValueError[U](nil)})
+	}
+
+	return Ok(optional.value)
+}
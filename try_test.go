@@ -0,0 +1,294 @@
+package optional
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+type capturingErrorFunction[T any, R any] struct {
+	arguments []T
+	result    R
+	err       error
+}
+
+func (f *capturingErrorFunction[T, R]) Invoke(input T) (R, error) {
+	f.arguments = append(f.arguments, input)
+
+	return f.result, f.err
+}
+
+func TestStepError(t *testing.T) {
+	var err error = &StepError{Step: "parse", Err: io.EOF}
+
+	expected := "parse: EOF"
+	if err.Error() != expected {
+		t.Errorf("message should be '%s', was '%s'", expected, err.Error())
+	}
+
+	if !errors.Is(err, io.EOF) {
+		t.Errorf("%v should match io.EOF", err)
+	}
+}
+
+func TestTryMapWhenEmpty(t *testing.T) {
+	opt := Empty[string]()
+
+	mapper := capturingErrorFunction[string, int]{result: 1}
+
+	mapped, err := TryMap(opt, mapper.Invoke)
+
+	if err != nil {
+		t.Errorf("TryMap called with an empty Optional should not return an error, was %v", err)
+	}
+
+	if mapped != Empty[int]() {
+		t.Errorf("TryMap called with an empty Optional should return an empty Optional, was %v", mapped)
+	}
+
+	if len(mapper.arguments) != 0 {
+		t.Errorf("mapper given to TryMap should not be invoked, was invoked with %v", mapper.arguments)
+	}
+}
+
+func TestTryMapWhenPresentReturningValue(t *testing.T) {
+	opt := Of("1")
+
+	mapped, err := TryMap(opt, strconv.Atoi)
+
+	if err != nil {
+		t.Errorf("TryMap should not return an error, was %v", err)
+	}
+
+	if mapped != Of(1) {
+		t.Errorf("TryMap should return optional.Of(1), was %v", mapped)
+	}
+}
+
+func TestTryMapWhenPresentReturningError(t *testing.T) {
+	opt := Of("foo")
+
+	mapper := capturingErrorFunction[string, int]{result: 1, err: io.EOF}
+
+	mapped, err := TryMap(opt, mapper.Invoke)
+
+	if !errors.Is(err, io.EOF) {
+		t.Errorf("TryMap should return io.EOF, was %v", err)
+	}
+
+	if mapped != Empty[int]() {
+		t.Errorf("TryMap should return an empty Optional, was %v", mapped)
+	}
+
+	if len(mapper.arguments) != 1 || mapper.arguments[0] != "foo" {
+		t.Errorf("mapper given to TryMap should be invoked with [foo], was %v", mapper.arguments)
+	}
+}
+
+func TestTryFlatMapWhenEmpty(t *testing.T) {
+	opt := Empty[string]()
+
+	mapper := capturingErrorFunction[string, Optional[int]]{result: Of(1)}
+
+	mapped, err := TryFlatMap(opt, mapper.Invoke)
+
+	if err != nil {
+		t.Errorf("TryFlatMap called with an empty Optional should not return an error, was %v", err)
+	}
+
+	if mapped != Empty[int]() {
+		t.Errorf("TryFlatMap called with an empty Optional should return an empty Optional, was %v", mapped)
+	}
+
+	if len(mapper.arguments) != 0 {
+		t.Errorf("mapper given to TryFlatMap should not be invoked, was invoked with %v", mapper.arguments)
+	}
+}
+
+func TestTryFlatMapWhenPresentReturningValue(t *testing.T) {
+	parameters := []Optional[int]{Of(1), Empty[int]()}
+
+	for i := range parameters {
+		expected := parameters[i]
+
+		t.Run(expected.String(), func(t *testing.T) {
+			opt := Of("foo")
+
+			mapper := capturingErrorFunction[string, Optional[int]]{result: expected}
+
+			mapped, err := TryFlatMap(opt, mapper.Invoke)
+
+			if err != nil {
+				t.Errorf("TryFlatMap should not return an error, was %v", err)
+			}
+
+			if mapped != expected {
+				t.Errorf("TryFlatMap should return %v, was %v", expected, mapped)
+			}
+
+			if len(mapper.arguments) != 1 || mapper.arguments[0] != "foo" {
+				t.Errorf("mapper given to TryFlatMap should be invoked with [foo], was %v", mapper.arguments)
+			}
+		})
+	}
+}
+
+func TestTryFlatMapWhenPresentReturningError(t *testing.T) {
+	opt := Of("foo")
+
+	mapper := capturingErrorFunction[string, Optional[int]]{result: Of(1), err: io.EOF}
+
+	mapped, err := TryFlatMap(opt, mapper.Invoke)
+
+	if !errors.Is(err, io.EOF) {
+		t.Errorf("TryFlatMap should return io.EOF, was %v", err)
+	}
+
+	if mapped != Empty[int]() {
+		t.Errorf("TryFlatMap should return an empty Optional, was %v", mapped)
+	}
+}
+
+func TestTryMapStepWhenErr(t *testing.T) {
+	result := Err[string](io.EOF)
+
+	mapper := capturingErrorFunction[string, int]{result: 1}
+
+	mapped := TryMapStep(result, "step", mapper.Invoke)
+
+	if !mapped.IsErr() || !errors.Is(mapped.Err(), io.EOF) {
+		t.Errorf("TryMapStep called with a failed Result should return a failed Result with the same error, was %v", mapped)
+	}
+
+	if len(mapper.arguments) != 0 {
+		t.Errorf("mapper given to TryMapStep should not be invoked, was invoked with %v", mapper.arguments)
+	}
+}
+
+func TestTryMapStepWhenOkReturningValue(t *testing.T) {
+	mapped := TryMapStep(Ok("1"), "parse", strconv.Atoi)
+
+	if mapped != Ok(1) {
+		t.Errorf("TryMapStep should return optional.Ok(1), was %v", mapped)
+	}
+}
+
+func TestTryMapStepWhenOkReturningError(t *testing.T) {
+	result := Ok("foo")
+
+	mapper := capturingErrorFunction[string, int]{result: 1, err: io.EOF}
+
+	mapped := TryMapStep(result, "step", mapper.Invoke)
+
+	var stepErr *StepError
+	if !errors.As(mapped.Err(), &stepErr) || stepErr.Step != "step" || !errors.Is(stepErr.Err, io.EOF) {
+		t.Errorf("TryMapStep should return a failed Result with a *StepError for step 'step', was %v", mapped)
+	}
+}
+
+func TestTryFlatMapStepWhenErr(t *testing.T) {
+	result := Err[string](io.EOF)
+
+	mapper := capturingErrorFunction[string, Optional[int]]{result: Of(1)}
+
+	mapped := TryFlatMapStep(result, "step", mapper.Invoke)
+
+	if !mapped.IsErr() || !errors.Is(mapped.Err(), io.EOF) {
+		t.Errorf("TryFlatMapStep called with a failed Result should return a failed Result with the same error, was %v", mapped)
+	}
+
+	if len(mapper.arguments) != 0 {
+		t.Errorf("mapper given to TryFlatMapStep should not be invoked, was invoked with %v", mapper.arguments)
+	}
+}
+
+func TestTryFlatMapStepWhenOkReturningValue(t *testing.T) {
+	result := Ok("foo")
+
+	mapper := capturingErrorFunction[string, Optional[int]]{result: Of(1)}
+
+	mapped := TryFlatMapStep(result, "step", mapper.Invoke)
+
+	if mapped != Ok(1) {
+		t.Errorf("TryFlatMapStep should return optional.Ok(1), was %v", mapped)
+	}
+}
+
+func TestTryFlatMapStepWhenOkReturningEmpty(t *testing.T) {
+	result := Ok("foo")
+
+	mapper := capturingErrorFunction[string, Optional[int]]{result: Empty[int]()}
+
+	mapped := TryFlatMapStep(result, "step", mapper.Invoke)
+
+	var stepErr *StepError
+	if !errors.As(mapped.Err(), &stepErr) || stepErr.Step != "step" || !errors.Is(stepErr.Err, ErrNoValuePresent) {
+		t.Errorf("TryFlatMapStep should return a failed Result with a *StepError for step 'step' matching ErrNoValuePresent, was %v", mapped)
+	}
+}
+
+func TestTryFlatMapStepWhenOkReturningError(t *testing.T) {
+	result := Ok("foo")
+
+	mapper := capturingErrorFunction[string, Optional[int]]{result: Of(1), err: io.EOF}
+
+	mapped := TryFlatMapStep(result, "step", mapper.Invoke)
+
+	var stepErr *StepError
+	if !errors.As(mapped.Err(), &stepErr) || stepErr.Step != "step" || !errors.Is(stepErr.Err, io.EOF) {
+		t.Errorf("TryFlatMapStep should return a failed Result with a *StepError for step 'step', was %v", mapped)
+	}
+}
+
+func TestTryMapStepChain(t *testing.T) {
+	errMissing := errors.New("missing")
+
+	trim := func(value string) (string, error) {
+		return strings.TrimSpace(value), nil
+	}
+	half := func(value int) (int, error) {
+		if value%2 != 0 {
+			return 0, errors.New("odd value")
+		}
+
+		return value / 2, nil
+	}
+
+	parameters := []struct {
+		name         string
+		input        Optional[string]
+		expected     Result[int]
+		expectedErr  error
+		expectedStep string
+	}{
+		{"valid", Of(" 4 "), Ok(2), nil, ""},
+		{"missing", Empty[string](), Result[int]{}, errMissing, ""},
+		{"invalid", Of(" foo "), Result[int]{}, strconv.ErrSyntax, "parse"},
+		{"odd", Of(" 3 "), Result[int]{}, nil, "half"},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			result := TryMapStep(TryMapStep(TryMapStep(parameter.input.Because(errMissing), "trim", trim), "parse", strconv.Atoi), "half", half)
+
+			err := result.Err()
+
+			var stepErr *StepError
+
+			switch {
+			case parameter.expectedErr == nil && parameter.expectedStep == "" && result != parameter.expected:
+				t.Errorf("chain should return %v, was %v", parameter.expected, result)
+			case parameter.expectedErr != nil && !errors.Is(err, parameter.expectedErr):
+				t.Errorf("chain should fail with an error matching %v, was %v", parameter.expectedErr, err)
+			case parameter.expectedStep != "" && !errors.As(err, &stepErr):
+				t.Errorf("chain should fail with a *StepError, was %v", err)
+			case parameter.expectedStep != "" && stepErr.Step != parameter.expectedStep:
+				t.Errorf("chain should fail at step '%s', was '%s'", parameter.expectedStep, stepErr.Step)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package optional
+
+import (
+	"encoding/xml"
+)
+
+// MarshalXML implements the [xml.Marshaler] interface.
+// An empty Optional is omitted entirely, a non-empty Optional is marshalled as its value.
+func (o Optional[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !o.present {
+		return nil
+	}
+
+	return e.EncodeElement(o.value, start)
+}
+
+// UnmarshalXML implements the [xml.Unmarshaler] interface.
+// The element is unmarshalled as a non-empty Optional.
+//
+// If an XML document does not contain an element for an Optional, this method is not called, and the Optional will keep its current value.
+// For newly created values that means it will remain empty.
+func (o *Optional[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var value T
+	if err := d.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+
+	*o = Of(value)
+
+	return nil
+}
+
+// MarshalXMLAttr implements the [xml.MarshalerAttr] interface.
+// An empty Optional is omitted entirely.
+//
+// A non-empty Optional is marshalled by delegating to the value if it implements [xml.MarshalerAttr],
+// or as if by [Optional.MarshalText] otherwise.
+func (o Optional[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !o.present {
+		return xml.Attr{}, nil
+	}
+
+	if marshaler, ok := any(o.value).(xml.MarshalerAttr); ok {
+		return marshaler.MarshalXMLAttr(name)
+	}
+
+	if marshaler, ok := any(&o.value).(xml.MarshalerAttr); ok {
+		return marshaler.MarshalXMLAttr(name)
+	}
+
+	text, err := marshalText(o.value)
+	if err != nil {
+		return xml.Attr{}, err
+	}
+
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+// UnmarshalXMLAttr implements the [xml.UnmarshalerAttr] interface.
+//
+// The attribute is unmarshalled by delegating to a pointer to the value if it implements [xml.UnmarshalerAttr],
+// or as if by [Optional.UnmarshalText] otherwise.
+//
+// If an XML element does not contain an attribute for an Optional, this method is not called, and the Optional will keep its current value.
+// For newly created values that means it will remain empty.
+func (o *Optional[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	var value T
+	if unmarshaler, ok := any(&value).(xml.UnmarshalerAttr); ok {
+		if err := unmarshaler.UnmarshalXMLAttr(attr); err != nil {
+			return err
+		}
+
+		*o = Of(value)
+
+		return nil
+	}
+
+	return o.UnmarshalText([]byte(attr.Value))
+}
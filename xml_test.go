@@ -0,0 +1,237 @@
+package optional
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+)
+
+type xmlOrder struct {
+	XMLName  xml.Name                `xml:"order"`
+	ID       int                     `xml:"id,attr"`
+	Priority Optional[int]           `xml:"priority,attr"`
+	Due      Optional[time.Duration] `xml:"due,attr"`
+	Customer Optional[string]        `xml:"customer"`
+	Note     Optional[string]        `xml:"note"`
+	Address  Optional[xmlAddress]    `xml:"address"`
+}
+
+type xmlAddress struct {
+	City    string           `xml:"city"`
+	Country Optional[string] `xml:"country"`
+}
+
+type xmlCode string
+
+func (c xmlCode) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: "code-" + string(c)}, nil
+}
+
+func (c *xmlCode) UnmarshalXMLAttr(attr xml.Attr) error {
+	*c = xmlCode("attr-" + attr.Value)
+
+	return nil
+}
+
+type xmlCoded struct {
+	XMLName xml.Name          `xml:"coded"`
+	Code    Optional[xmlCode] `xml:"code,attr"`
+}
+
+func TestMarshalXMLWithEmptyOptionals(t *testing.T) {
+	order := xmlOrder{ID: 1}
+
+	data, err := xml.Marshal(order)
+	if err != nil {
+		t.Fatalf("xml.Marshal should not return an error, was %v", err)
+	}
+
+	expected := `<order id="1"></order>`
+	if string(data) != expected {
+		t.Errorf("xml.Marshal should return '%s', was '%s'", expected, data)
+	}
+}
+
+func TestMarshalXMLWithPresentOptionals(t *testing.T) {
+	order := xmlOrder{
+		ID:       1,
+		Priority: Of(2),
+		Due:      Of(time.Hour),
+		Customer: Of("John"),
+		Address:  Of(xmlAddress{City: "Amsterdam"}),
+	}
+
+	data, err := xml.Marshal(order)
+	if err != nil {
+		t.Fatalf("xml.Marshal should not return an error, was %v", err)
+	}
+
+	expected := `<order id="1" priority="2" due="1h0m0s"><customer>John</customer><address><city>Amsterdam</city></address></order>`
+	if string(data) != expected {
+		t.Errorf("xml.Marshal should return '%s', was '%s'", expected, data)
+	}
+}
+
+func TestMarshalXMLWithNestedOptionals(t *testing.T) {
+	type nested struct {
+		XMLName xml.Name                   `xml:"nested"`
+		Value   Optional[Optional[string]] `xml:"value"`
+	}
+
+	parameters := []struct {
+		name     string
+		value    Optional[Optional[string]]
+		expected string
+	}{
+		{"empty", Empty[Optional[string]](), `<nested></nested>`},
+		{"present empty", Of(Empty[string]()), `<nested></nested>`},
+		{"present present", Of(Of("foo")), `<nested><value>foo</value></nested>`},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			data, err := xml.Marshal(nested{Value: parameter.value})
+			if err != nil {
+				t.Fatalf("xml.Marshal should not return an error, was %v", err)
+			}
+
+			if string(data) != parameter.expected {
+				t.Errorf("xml.Marshal should return '%s', was '%s'", parameter.expected, data)
+			}
+		})
+	}
+}
+
+func TestMarshalXMLAttrWithMarshalerAttr(t *testing.T) {
+	data, err := xml.Marshal(xmlCoded{Code: Of(xmlCode("a"))})
+	if err != nil {
+		t.Fatalf("xml.Marshal should not return an error, was %v", err)
+	}
+
+	expected := `<coded code="code-a"></coded>`
+	if string(data) != expected {
+		t.Errorf("xml.Marshal should return '%s', was '%s'", expected, data)
+	}
+}
+
+func TestMarshalXMLAttrWithUnsupportedType(t *testing.T) {
+	type unsupported struct {
+		XMLName xml.Name        `xml:"unsupported"`
+		Values  Optional[[]int] `xml:"values,attr"`
+	}
+
+	if _, err := xml.Marshal(unsupported{Values: Of([]int{1})}); err == nil {
+		t.Errorf("xml.Marshal with an unsupported attribute type should return an error")
+	}
+}
+
+func TestUnmarshalXMLWithMissingOptionals(t *testing.T) {
+	var order xmlOrder
+
+	if err := xml.Unmarshal([]byte(`<order id="1"></order>`), &order); err != nil {
+		t.Fatalf("xml.Unmarshal should not return an error, was %v", err)
+	}
+
+	if order.ID != 1 {
+		t.Errorf("id should be 1, was %v", order.ID)
+	}
+
+	if !order.Priority.IsEmpty() || !order.Due.IsEmpty() || !order.Customer.IsEmpty() || !order.Note.IsEmpty() || !order.Address.IsEmpty() {
+		t.Errorf("missing elements and attributes should be empty, was %+v", order)
+	}
+}
+
+func TestUnmarshalXMLWithPresentOptionals(t *testing.T) {
+	var order xmlOrder
+
+	data := `<order id="1" priority="2" due="1h"><customer>John</customer><note></note><address><city>Amsterdam</city></address></order>`
+	if err := xml.Unmarshal([]byte(data), &order); err != nil {
+		t.Fatalf("xml.Unmarshal should not return an error, was %v", err)
+	}
+
+	if order.Priority != Of(2) {
+		t.Errorf("priority should be optional.Of(2), was %v", order.Priority)
+	}
+
+	if order.Due != Of(time.Hour) {
+		t.Errorf("due should be optional.Of(1h), was %v", order.Due)
+	}
+
+	if order.Customer != Of("John") {
+		t.Errorf("customer should be optional.Of('John'), was %v", order.Customer)
+	}
+
+	if order.Note != Of("") {
+		t.Errorf("note should be optional.Of(''), was %v", order.Note)
+	}
+
+	if order.Address != Of(xmlAddress{City: "Amsterdam"}) {
+		t.Errorf("address should be optional.Of({Amsterdam}), was %v", order.Address)
+	}
+}
+
+func TestUnmarshalXMLWithInvalidValues(t *testing.T) {
+	type counter struct {
+		XMLName xml.Name      `xml:"counter"`
+		Start   Optional[int] `xml:"start,attr"`
+		Count   Optional[int] `xml:"count"`
+	}
+
+	parameters := []struct {
+		name string
+		data string
+	}{
+		{"element", `<counter><count>foo</count></counter>`},
+		{"attribute", `<counter start="foo"></counter>`},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			var c counter
+
+			if err := xml.Unmarshal([]byte(parameter.data), &c); err == nil {
+				t.Errorf("xml.Unmarshal with an invalid %s should return an error", parameter.name)
+			}
+		})
+	}
+}
+
+func TestUnmarshalXMLAttrWithUnmarshalerAttr(t *testing.T) {
+	var coded xmlCoded
+
+	if err := xml.Unmarshal([]byte(`<coded code="a"></coded>`), &coded); err != nil {
+		t.Fatalf("xml.Unmarshal should not return an error, was %v", err)
+	}
+
+	if coded.Code != Of(xmlCode("attr-a")) {
+		t.Errorf("code should be optional.Of('attr-a'), was %v", coded.Code)
+	}
+}
+
+func TestXMLRoundTrip(t *testing.T) {
+	original := xmlOrder{
+		ID:       1,
+		Priority: Of(0),
+		Customer: Of("John"),
+		Address:  Of(xmlAddress{City: "Amsterdam", Country: Of("NL")}),
+	}
+
+	data, err := xml.Marshal(original)
+	if err != nil {
+		t.Fatalf("xml.Marshal should not return an error, was %v", err)
+	}
+
+	var result xmlOrder
+	if err := xml.Unmarshal(data, &result); err != nil {
+		t.Fatalf("xml.Unmarshal should not return an error, was %v", err)
+	}
+
+	result.XMLName = xml.Name{}
+	if result != original {
+		t.Errorf("round trip should result in %+v, was %+v", original, result)
+	}
+}
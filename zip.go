@@ -0,0 +1,100 @@
+package optional
+
+// Pair is a tuple of two values.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple is a tuple of three values.
+type Triple[A any, B any, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Quadruple is a tuple of four values.
+type Quadruple[A any, B any, C any, D any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+}
+
+// Zip returns a non-empty Optional containing a Pair of the values of the given Optionals if both are present, or an empty Optional otherwise.
+func Zip[A any, B any](a Optional[A], b Optional[B]) Optional[Pair[A, B]] {
+	return ZipWith(a, b, func(first A, second B) Pair[A, B] {
+		return Pair[A, B]{First: first, Second: second}
+	})
+}
+
+// Zip3 returns a non-empty Optional containing a Triple of the values of the given Optionals if all are present, or an empty Optional otherwise.
+func Zip3[A any, B any, C any](a Optional[A], b Optional[B], c Optional[C]) Optional[Triple[A, B, C]] {
+	return ZipWith3(a, b, c, func(first A, second B, third C) Triple[A, B, C] {
+		return Triple[A, B, C]{First: first, Second: second, Third: third}
+	})
+}
+
+// Zip4 returns a non-empty Optional containing a Quadruple of the values of the given Optionals if all are present, or an empty Optional otherwise.
+func Zip4[A any, B any, C any, D any](a Optional[A], b Optional[B], c Optional[C], d Optional[D]) Optional[Quadruple[A, B, C, D]] {
+	return ZipWith4(a, b, c, d, func(first A, second B, third C, fourth D) Quadruple[A, B, C, D] {
+		return Quadruple[A, B, C, D]{First: first, Second: second, Third: third, Fourth: fourth}
+	})
+}
+
+// ZipWith returns a non-empty Optional containing the result of calling the given zipper function on the values of the given Optionals if both are present,
+// or an empty Optional otherwise.
+func ZipWith[A any, B any, R any](a Optional[A], b Optional[B], zipper func(first A, second B) R) Optional[R] {
+	if !a.present || !b.present {
+		return Empty[R]()
+	}
+
+	return Of(zipper(a.value, b.value))
+}
+
+// ZipWith3 returns a non-empty Optional containing the result of calling the given zipper function on the values of the given Optionals if all are present,
+// or an empty Optional otherwise.
+func ZipWith3[A any, B any, C any, R any](a Optional[A], b Optional[B], c Optional[C], zipper func(first A, second B, third C) R) Optional[R] {
+	if !a.present || !b.present || !c.present {
+		return Empty[R]()
+	}
+
+	return Of(zipper(a.value, b.value, c.value))
+}
+
+// ZipWith4 returns a non-empty Optional containing the result of calling the given zipper function on the values of the given Optionals if all are present,
+// or an empty Optional otherwise.
+func ZipWith4[A any, B any, C any, D any, R any](a Optional[A], b Optional[B], c Optional[C], d Optional[D], zipper func(first A, second B, third C, fourth D) R) Optional[R] {
+	if !a.present || !b.present || !c.present || !d.present {
+		return Empty[R]()
+	}
+
+	return Of(zipper(a.value, b.value, c.value, d.value))
+}
+
+// Unzip returns two non-empty Optionals containing the values of the given Optional's Pair if present, or two empty Optionals otherwise.
+func Unzip[A any, B any](optional Optional[Pair[A, B]]) (Optional[A], Optional[B]) {
+	if !optional.present {
+		return Empty[A](), Empty[B]()
+	}
+
+	return Of(optional.value.First), Of(optional.value.Second)
+}
+
+// Unzip3 returns three non-empty Optionals containing the values of the given Optional's Triple if present, or three empty Optionals otherwise.
+func Unzip3[A any, B any, C any](optional Optional[Triple[A, B, C]]) (Optional[A], Optional[B], Optional[C]) {
+	if !optional.present {
+		return Empty[A](), Empty[B](), Empty[C]()
+	}
+
+	return Of(optional.value.First), Of(optional.value.Second), Of(optional.value.Third)
+}
+
+// Unzip4 returns four non-empty Optionals containing the values of the given Optional's Quadruple if present, or four empty Optionals otherwise.
+func Unzip4[A any, B any, C any, D any](optional Optional[Quadruple[A, B, C, D]]) (Optional[A], Optional[B], Optional[C], Optional[D]) {
+	if !optional.present {
+		return Empty[A](), Empty[B](), Empty[C](), Empty[D]()
+	}
+
+	return Of(optional.value.First), Of(optional.value.Second), Of(optional.value.Third), Of(optional.value.Fourth)
+}
@@ -0,0 +1,194 @@
+package optional
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	parameters := []struct {
+		name     string
+		a        Optional[int]
+		b        Optional[string]
+		expected Optional[Pair[int, string]]
+	}{
+		{"both empty", Empty[int](), Empty[string](), Empty[Pair[int, string]]()},
+		{"first empty", Empty[int](), Of("foo"), Empty[Pair[int, string]]()},
+		{"second empty", Of(1), Empty[string](), Empty[Pair[int, string]]()},
+		{"both present", Of(1), Of("foo"), Of(Pair[int, string]{First: 1, Second: "foo"})},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			if zipped := Zip(parameter.a, parameter.b); zipped != parameter.expected {
+				t.Errorf("Zip should return %v, was %v", parameter.expected, zipped)
+			}
+		})
+	}
+}
+
+func TestZip3(t *testing.T) {
+	parameters := []struct {
+		name     string
+		a        Optional[int]
+		b        Optional[string]
+		c        Optional[bool]
+		expected Optional[Triple[int, string, bool]]
+	}{
+		{"first empty", Empty[int](), Of("foo"), Of(true), Empty[Triple[int, string, bool]]()},
+		{"second empty", Of(1), Empty[string](), Of(true), Empty[Triple[int, string, bool]]()},
+		{"third empty", Of(1), Of("foo"), Empty[bool](), Empty[Triple[int, string, bool]]()},
+		{"all present", Of(1), Of("foo"), Of(true), Of(Triple[int, string, bool]{First: 1, Second: "foo", Third: true})},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			if zipped := Zip3(parameter.a, parameter.b, parameter.c); zipped != parameter.expected {
+				t.Errorf("Zip3 should return %v, was %v", parameter.expected, zipped)
+			}
+		})
+	}
+}
+
+func TestZip4(t *testing.T) {
+	parameters := []struct {
+		name     string
+		a        Optional[int]
+		b        Optional[string]
+		c        Optional[bool]
+		d        Optional[float64]
+		expected Optional[Quadruple[int, string, bool, float64]]
+	}{
+		{"first empty", Empty[int](), Of("foo"), Of(true), Of(1.5), Empty[Quadruple[int, string, bool, float64]]()},
+		{"second empty", Of(1), Empty[string](), Of(true), Of(1.5), Empty[Quadruple[int, string, bool, float64]]()},
+		{"third empty", Of(1), Of("foo"), Empty[bool](), Of(1.5), Empty[Quadruple[int, string, bool, float64]]()},
+		{"fourth empty", Of(1), Of("foo"), Of(true), Empty[float64](), Empty[Quadruple[int, string, bool, float64]]()},
+		{"all present", Of(1), Of("foo"), Of(true), Of(1.5), Of(Quadruple[int, string, bool, float64]{First: 1, Second: "foo", Third: true, Fourth: 1.5})},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			if zipped := Zip4(parameter.a, parameter.b, parameter.c, parameter.d); zipped != parameter.expected {
+				t.Errorf("Zip4 should return %v, was %v", parameter.expected, zipped)
+			}
+		})
+	}
+}
+
+func TestZipWith(t *testing.T) {
+	zipper := func(value int, s string) string {
+		return s + strconv.Itoa(value)
+	}
+
+	if zipped := ZipWith(Of(1), Of("foo"), zipper); zipped != Of("foo1") {
+		t.Errorf("ZipWith should return optional.Of('foo1'), was %v", zipped)
+	}
+
+	zipped := ZipWith(Of(1), Empty[string](), func(int, string) string {
+		t.Error("zipper given to ZipWith should not be invoked")
+
+		return ""
+	})
+	if zipped != Empty[string]() {
+		t.Errorf("ZipWith should return an empty Optional, was %v", zipped)
+	}
+}
+
+func TestZipWith3(t *testing.T) {
+	zipper := func(a int, b int, c int) int {
+		return a + b + c
+	}
+
+	if zipped := ZipWith3(Of(1), Of(2), Of(3), zipper); zipped != Of(6) {
+		t.Errorf("ZipWith3 should return optional.Of(6), was %v", zipped)
+	}
+
+	zipped := ZipWith3(Of(1), Of(2), Empty[int](), zipper)
+	if zipped != Empty[int]() {
+		t.Errorf("ZipWith3 should return an empty Optional, was %v", zipped)
+	}
+}
+
+func TestZipWith4(t *testing.T) {
+	type config struct {
+		host    string
+		port    int
+		secure  bool
+		retries uint
+	}
+
+	zipper := func(host string, port int, secure bool, retries uint) config {
+		return config{host, port, secure, retries}
+	}
+
+	zipped := ZipWith4(Of("localhost"), Of(8080), Of(true), Of(uint(3)), zipper)
+	if expected := Of(config{"localhost", 8080, true, 3}); zipped != expected {
+		t.Errorf("ZipWith4 should return %v, was %v", expected, zipped)
+	}
+
+	parameters := []struct {
+		name    string
+		host    Optional[string]
+		port    Optional[int]
+		secure  Optional[bool]
+		retries Optional[uint]
+	}{
+		{"first empty", Empty[string](), Of(8080), Of(true), Of(uint(3))},
+		{"second empty", Of("localhost"), Empty[int](), Of(true), Of(uint(3))},
+		{"third empty", Of("localhost"), Of(8080), Empty[bool](), Of(uint(3))},
+		{"fourth empty", Of("localhost"), Of(8080), Of(true), Empty[uint]()},
+	}
+
+	for i := range parameters {
+		parameter := parameters[i]
+
+		t.Run(parameter.name, func(t *testing.T) {
+			zipped := ZipWith4(parameter.host, parameter.port, parameter.secure, parameter.retries, zipper)
+			if zipped != Empty[config]() {
+				t.Errorf("ZipWith4 should return an empty Optional, was %v", zipped)
+			}
+		})
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	a, b := Unzip(Of(Pair[int, string]{First: 1, Second: "foo"}))
+	if a != Of(1) || b != Of("foo") {
+		t.Errorf("Unzip should return (optional.Of(1), optional.Of('foo')), was (%v, %v)", a, b)
+	}
+
+	a, b = Unzip(Empty[Pair[int, string]]())
+	if a != Empty[int]() || b != Empty[string]() {
+		t.Errorf("Unzip should return two empty Optionals, was (%v, %v)", a, b)
+	}
+}
+
+func TestUnzip3(t *testing.T) {
+	a, b, c := Unzip3(Zip3(Of(1), Of("foo"), Of(true)))
+	if a != Of(1) || b != Of("foo") || c != Of(true) {
+		t.Errorf("Unzip3 should return (optional.Of(1), optional.Of('foo'), optional.Of(true)), was (%v, %v, %v)", a, b, c)
+	}
+
+	a, b, c = Unzip3(Empty[Triple[int, string, bool]]())
+	if !a.IsEmpty() || !b.IsEmpty() || !c.IsEmpty() {
+		t.Errorf("Unzip3 should return three empty Optionals, was (%v, %v, %v)", a, b, c)
+	}
+}
+
+func TestUnzip4(t *testing.T) {
+	a, b, c, d := Unzip4(Zip4(Of(1), Of("foo"), Of(true), Of(1.5)))
+	if a != Of(1) || b != Of("foo") || c != Of(true) || d != Of(1.5) {
+		t.Errorf("Unzip4 should return (optional.Of(1), optional.Of('foo'), optional.Of(true), optional.Of(1.5)), was (%v, %v, %v, %v)", a, b, c, d)
+	}
+
+	a, b, c, d = Unzip4(Empty[Quadruple[int, string, bool, float64]]())
+	if !a.IsEmpty() || !b.IsEmpty() || !c.IsEmpty() || !d.IsEmpty() {
+		t.Errorf("Unzip4 should return four empty Optionals, was (%v, %v, %v, %v)", a, b, c, d)
+	}
+}